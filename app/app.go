@@ -19,9 +19,11 @@ import (
 	"github.com/barkisnet/barkis/version"
 	"github.com/barkisnet/barkis/x/asset"
 	"github.com/barkisnet/barkis/x/auth"
+	"github.com/barkisnet/barkis/x/authz"
 	"github.com/barkisnet/barkis/x/bank"
 	"github.com/barkisnet/barkis/x/crisis"
 	distr "github.com/barkisnet/barkis/x/distribution"
+	"github.com/barkisnet/barkis/x/feegrant"
 	"github.com/barkisnet/barkis/x/genaccounts"
 	"github.com/barkisnet/barkis/x/genutil"
 	"github.com/barkisnet/barkis/x/gov"
@@ -53,12 +55,15 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler,
+			distr.ContinuousFundProposalHandler, distr.CancelContinuousFundProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
 		asset.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		authz.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -110,6 +115,11 @@ type BarkisApp struct {
 	crisisKeeper   crisis.Keeper
 	paramsKeeper   params.Keeper
 	assetKeeper    asset.Keeper
+	feeGrantKeeper feegrant.Keeper
+	authzKeeper    authz.Keeper
+
+	// the node-local database of the reward ledger, if enabled
+	rewardLedgerDB dbm.DB
 
 	// the module manager
 	mm *module.Manager
@@ -128,7 +138,8 @@ func NewBarkisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	keys := sdk.NewKVStoreKeys(
 		bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey, asset.StoreKey,
+		gov.StoreKey, params.StoreKey, asset.StoreKey, bank.StoreKey,
+		feegrant.StoreKey, authz.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
@@ -154,7 +165,7 @@ func NewBarkisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
-	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper, bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
+	app.bankKeeper = bank.NewBaseKeeper(keys[bank.StoreKey], app.accountKeeper, bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
 	app.supplyKeeper = supply.NewKeeper(app.cdc, keys[supply.StoreKey], app.accountKeeper, app.bankKeeper, maccPerms)
 	stakingKeeper := staking.NewKeeper(
 		app.cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey],
@@ -163,6 +174,14 @@ func NewBarkisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.mintKeeper = mint.NewKeeper(app.cdc, keys[mint.StoreKey], mintSubspace, app.supplyKeeper, auth.FeeCollectorName)
 	app.distrKeeper = distr.NewKeeper(app.cdc, keys[distr.StoreKey], distrSubspace, &stakingKeeper,
 		app.supplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName, app.ModuleAccountAddrs())
+	if interval := BarkisContext.RewardLedgerInterval; interval > 0 {
+		ledgerDB, err := sdk.NewLevelDB("reward_ledger", BarkisContext.Config.DBDir())
+		if err != nil {
+			panic(err)
+		}
+		app.rewardLedgerDB = ledgerDB
+		app.distrKeeper.SetRewardLedger(distr.NewRewardLedger(ledgerDB, interval))
+	}
 	app.slashingKeeper = slashing.NewKeeper(
 		app.cdc, keys[slashing.StoreKey], &stakingKeeper, slashingSubspace, slashing.DefaultCodespace,
 	)
@@ -175,7 +194,7 @@ func NewBarkisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper))
 	app.govKeeper = gov.NewKeeper(
 		app.cdc, keys[gov.StoreKey], app.paramsKeeper, govSubspace,
-		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter,
+		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter, app.Router(),
 	)
 
 	// register the staking hooks
@@ -184,7 +203,12 @@ func NewBarkisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
 	)
 
+	// register the governance hooks
+	app.govKeeper.SetHooks(app.slashingKeeper.GovHooks())
+
 	app.assetKeeper = asset.NewKeeper(cdc, keys[asset.StoreKey], assetSubspace, app.supplyKeeper, asset.DefaultCodespace)
+	app.feeGrantKeeper = feegrant.NewKeeper(cdc, keys[feegrant.StoreKey], feegrant.DefaultCodespace)
+	app.authzKeeper = authz.NewKeeper(cdc, keys[authz.StoreKey], app.Router(), authz.DefaultCodespace)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -201,6 +225,8 @@ func NewBarkisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.distrKeeper, app.accountKeeper, app.supplyKeeper),
 		asset.NewAppModule(app.assetKeeper),
+		feegrant.NewAppModule(app.feeGrantKeeper),
+		authz.NewAppModule(app.authzKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -208,7 +234,7 @@ func NewBarkisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, distr.ModuleName, staking.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -216,6 +242,7 @@ func NewBarkisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		genaccounts.ModuleName, distr.ModuleName, staking.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
 		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName, asset.ModuleName,
+		feegrant.ModuleName, authz.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -228,7 +255,9 @@ func NewBarkisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(bank.NewMemoRequiredAnteHandler(app.bankKeeper,
+		auth.NewAnteHandlerWithFeeGrant(app.accountKeeper, app.supplyKeeper, app.feeGrantKeeper, app.stakingKeeper,
+			auth.DefaultSigVerificationGasConsumer)))
 	app.SetEndBlocker(app.EndBlocker)
 
 	app.registerUpgrade()
@@ -303,6 +332,79 @@ func (app *BarkisApp) registerUpgrade() {
 
 	//------------------------------------------------------------------------------------------------------------------------------------
 	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.TokenDesLenLimitUpgradeHeight, BarkisContext.UpgradeConfig.TokenDesLenLimitUpgradeHeight)
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.MemoRequiredUpgrade, BarkisContext.UpgradeConfig.MemoRequiredUpgrade)
+	sdk.GlobalUpgradeMgr.RegisterNewStore(sdk.MemoRequiredUpgrade, bank.StoreKey)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg(sdk.MemoRequiredUpgrade, bank.MsgSetMemoRequired{}.Type())
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.VestingAccountUpgrade, BarkisContext.UpgradeConfig.VestingAccountUpgrade)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg(sdk.VestingAccountUpgrade, bank.MsgCreateVestingAccount{}.Type(), bank.MsgCreatePeriodicVestingAccount{}.Type())
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.FeeTokenUpgrade, BarkisContext.UpgradeConfig.FeeTokenUpgrade)
+
+	sdk.GlobalUpgradeMgr.RegisterBeginBlockerFirst(sdk.FeeTokenUpgrade, func(ctx sdk.Context) {
+		app.accountKeeper.SetFeeTokens(ctx, auth.FeeTokens{}) // fee tokens are whitelisted by governance
+	})
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.FeeGrantUpgrade, BarkisContext.UpgradeConfig.FeeGrantUpgrade)
+	sdk.GlobalUpgradeMgr.RegisterNewStore(sdk.FeeGrantUpgrade, feegrant.StoreKey)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg(sdk.FeeGrantUpgrade, feegrant.MsgGrantFeeAllowance{}.Type(), feegrant.MsgRevokeFeeAllowance{}.Type())
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.AuthzUpgrade, BarkisContext.UpgradeConfig.AuthzUpgrade)
+	sdk.GlobalUpgradeMgr.RegisterNewStore(sdk.AuthzUpgrade, authz.StoreKey)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg(sdk.AuthzUpgrade, authz.MsgGrant{}.Type(), authz.MsgRevoke{}.Type(), authz.MsgExec{}.Type())
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.AutoRestakeUpgrade, BarkisContext.UpgradeConfig.AutoRestakeUpgrade)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg(sdk.AutoRestakeUpgrade, distr.MsgSetAutoRestake{}.Type())
+
+	sdk.GlobalUpgradeMgr.RegisterBeginBlockerFirst(sdk.AutoRestakeUpgrade, func(ctx sdk.Context) {
+		app.distrKeeper.SetAutoRestakeInterval(ctx, distr.DefaultAutoRestakeInterval)
+		app.distrKeeper.SetMaxAutoRestakesPerBlock(ctx, distr.DefaultMaxAutoRestakesPerBlock)
+	})
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.WithdrawAllRewardsUpgrade, BarkisContext.UpgradeConfig.WithdrawAllRewardsUpgrade)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg(sdk.WithdrawAllRewardsUpgrade, distr.MsgWithdrawAllRewards{}.Type())
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.ContinuousFundUpgrade, BarkisContext.UpgradeConfig.ContinuousFundUpgrade)
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.WeightedVoteUpgrade, BarkisContext.UpgradeConfig.WeightedVoteUpgrade)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg(sdk.WeightedVoteUpgrade, gov.MsgVoteWeighted{}.Type())
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.ExpeditedProposalUpgrade, BarkisContext.UpgradeConfig.ExpeditedProposalUpgrade)
+
+	sdk.GlobalUpgradeMgr.RegisterBeginBlockerFirst(sdk.ExpeditedProposalUpgrade, func(ctx sdk.Context) {
+		minDeposit := app.govKeeper.GetDepositParams(ctx).MinDeposit
+		votingPeriod := app.govKeeper.GetVotingParams(ctx).VotingPeriod
+		expeditedMinDeposit := sdk.Coins{}
+		for _, coin := range minDeposit {
+			expeditedMinDeposit = expeditedMinDeposit.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(5))))
+		}
+		// five times the normal deposit, a quarter of the normal voting period
+		app.govKeeper.SetExpeditedParams(ctx, gov.NewExpeditedParams(expeditedMinDeposit, votingPeriod/4, gov.DefaultExpeditedParams().Threshold))
+	})
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.ExecMsgsProposalUpgrade, BarkisContext.UpgradeConfig.ExecMsgsProposalUpgrade)
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.TransferDelegationUpgrade, BarkisContext.UpgradeConfig.TransferDelegationUpgrade)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg(sdk.TransferDelegationUpgrade, staking.MsgTransferDelegation{}.Type())
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.GovVoteSlashingUpgrade, BarkisContext.UpgradeConfig.GovVoteSlashingUpgrade)
+
+	//------------------------------------------------------------------------------------------------------------------------------------
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.TombstoneUpgrade, BarkisContext.UpgradeConfig.TombstoneUpgrade)
 }
 
 // application updates every begin block
@@ -336,6 +438,14 @@ func (app *BarkisApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height, app.keys[bam.MainStoreKey])
 }
 
+// Close closes the databases opened by the app itself on shutdown
+func (app *BarkisApp) Close() error {
+	if app.rewardLedgerDB != nil {
+		app.rewardLedgerDB.Close()
+	}
+	return nil
+}
+
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *BarkisApp) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
@@ -3,13 +3,20 @@ package app
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tm-db"
 
 	"github.com/barkisnet/barkis/codec"
 	"github.com/barkisnet/barkis/simapp"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/asset"
+	"github.com/barkisnet/barkis/x/bank"
+	"github.com/barkisnet/barkis/x/gov"
+	"github.com/barkisnet/barkis/x/staking"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -24,6 +31,188 @@ func TestBarkisdExport(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestBarkisdCheckInvariants(t *testing.T) {
+	db := db.NewMemDB()
+	gapp := NewBarkisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0)
+	setGenesis(gapp)
+
+	newGapp := NewBarkisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0)
+	results := newGapp.CheckInvariants()
+	require.NotEmpty(t, results)
+	require.Len(t, results, len(newGapp.crisisKeeper.Routes()))
+	for _, res := range results {
+		require.False(t, res.Broken, "invariant %s broken: %s", res.Route, res.Message)
+	}
+}
+
+func TestAutoRestakeEndBlock(t *testing.T) {
+	gapp := NewBarkisApp(log.NewNopLogger(), db.NewMemDB(), nil, true, 0)
+	require.NoError(t, setGenesis(gapp))
+
+	// the upgrade heights are loaded when the app is created
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.AutoRestakeUpgrade, 2)
+	defer func() {
+		delete(sdk.GlobalUpgradeMgr.Config.UpgradeHeight, sdk.AutoRestakeUpgrade)
+		sdk.GlobalUpgradeMgr.SetBlockHeight(0)
+	}()
+
+	// create a validator with a self delegation opted in to auto-restaking
+	header := abci.Header{Height: 2}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := gapp.NewContext(false, header)
+
+	valPubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(valPubKey.Address())
+	delAddr := sdk.AccAddress(valAddr)
+	tokens := sdk.TokensFromConsensusPower(100)
+
+	acc := gapp.accountKeeper.NewAccountWithAddress(ctx, delAddr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+	gapp.accountKeeper.SetAccount(ctx, acc)
+
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(valAddr, valPubKey, sdk.NewCoin(sdk.DefaultBondDenom, tokens),
+		staking.Description{Moniker: "validator"}, commission, sdk.OneInt())
+	res := staking.NewHandler(gapp.stakingKeeper)(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	require.Nil(t, gapp.distrKeeper.SetAutoRestakeEnabled(ctx, delAddr, valAddr, true))
+	gapp.distrKeeper.SetAutoRestakeInterval(ctx, 1)
+
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	gapp.Commit()
+
+	// allocate rewards to the validator in the next block
+	header = abci.Header{Height: 3}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = gapp.NewContext(false, header)
+
+	rewards := sdk.TokensFromConsensusPower(10)
+	distrAcc := gapp.distrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, distrAcc.SetCoins(distrAcc.GetCoins().Add(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards)))))
+	gapp.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+	val := gapp.stakingKeeper.Validator(ctx, valAddr)
+	gapp.distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, rewards)})
+
+	delegation, found := gapp.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	shares := delegation.GetShares()
+
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	gapp.Commit()
+
+	// the rewards net of commission are restaked by the end blocker
+	ctx = gapp.NewContext(true, header)
+	delegation, found = gapp.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.True(t, delegation.GetShares().GT(shares), "shares %s, restaked %s", shares, delegation.GetShares())
+}
+
+func TestExecMsgsProposalGovFunds(t *testing.T) {
+	gapp := NewBarkisApp(log.NewNopLogger(), db.NewMemDB(), nil, true, 0)
+	require.NoError(t, setGenesis(gapp))
+
+	// the upgrade heights are loaded when the app is created
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.TokenIssueUpgrade, 2)
+	sdk.GlobalUpgradeMgr.RegisterNewStore(sdk.TokenIssueUpgrade, asset.StoreKey)
+	sdk.GlobalUpgradeMgr.RegisterNewMsg(sdk.TokenIssueUpgrade, asset.IssueMsg{}.Type(), asset.MintMsg{}.Type())
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.ExecMsgsProposalUpgrade, 2)
+	defer func() {
+		delete(sdk.GlobalUpgradeMgr.Config.UpgradeHeight, sdk.TokenIssueUpgrade)
+		delete(sdk.GlobalUpgradeMgr.Config.NewStoreHeight, asset.StoreKey)
+		delete(sdk.GlobalUpgradeMgr.Config.NewMsgHeight, asset.IssueMsg{}.Type())
+		delete(sdk.GlobalUpgradeMgr.Config.NewMsgHeight, asset.MintMsg{}.Type())
+		delete(sdk.GlobalUpgradeMgr.Config.UpgradeHeight, sdk.ExecMsgsProposalUpgrade)
+		sdk.GlobalUpgradeMgr.SetBlockHeight(0)
+	}()
+
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	header := abci.Header{Height: 2, Time: startTime}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := gapp.NewContext(false, header)
+	gapp.assetKeeper.SetParams(ctx, asset.NewParams(10, sdk.Coins{}, sdk.Coins{}))
+
+	// create a validator to vote on the proposals, and a depositor
+	valPubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(valPubKey.Address())
+	voterAddr := sdk.AccAddress(valAddr)
+	depositorAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipientAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tokens := sdk.TokensFromConsensusPower(100)
+	for _, addr := range []sdk.AccAddress{voterAddr, depositorAddr} {
+		acc := gapp.accountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))))
+		gapp.accountKeeper.SetAccount(ctx, acc)
+	}
+
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(valAddr, valPubKey, sdk.NewCoin(sdk.DefaultBondDenom, tokens),
+		staking.Description{Moniker: "validator"}, commission, sdk.OneInt())
+	res := staking.NewHandler(gapp.stakingKeeper)(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	// the deposit of a proposal still in its deposit period is held by the
+	// governance module account while the msgs are executed
+	govHandler := gov.NewHandler(gapp.govKeeper)
+	depositParams := gapp.govKeeper.GetDepositParams(ctx)
+	depositParams.MaxDepositPeriod *= 2
+	gapp.govKeeper.SetDepositParams(ctx, depositParams)
+	minDeposit := depositParams.MinDeposit
+	halfDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, minDeposit.AmountOf(sdk.DefaultBondDenom).QuoRaw(2)))
+	res = govHandler(ctx, gov.NewMsgSubmitProposal(gov.NewTextProposal("text", "text"), halfDeposit, depositorAddr))
+	require.True(t, res.IsOK(), res.Log)
+
+	govAddr := gapp.supplyKeeper.GetModuleAddress(gov.ModuleName)
+	proposalMsgs := [][]sdk.Msg{
+		{
+			asset.IssueMsg{From: govAddr, Name: "governance", Symbol: "gov", TotalSupply: 1000, Mintable: true, Description: "governance token"},
+			asset.MintMsg{From: govAddr, Symbol: "gov", Amount: 500},
+		},
+		{bank.MsgSend{FromAddress: govAddr, ToAddress: recipientAddr, Amount: sdk.NewCoins(sdk.NewInt64Coin("gov", 300))}},
+		{bank.MsgSend{FromAddress: govAddr, ToAddress: recipientAddr, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))}},
+	}
+	var proposalIDs []uint64
+	for i, msgs := range proposalMsgs {
+		content := gov.NewExecMsgsProposal("exec", "exec", msgs)
+		res = govHandler(ctx, gov.NewMsgSubmitProposal(content, minDeposit, depositorAddr))
+		require.True(t, res.IsOK(), "proposal %d: %s", i, res.Log)
+		var proposalID uint64
+		gov.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+		proposalIDs = append(proposalIDs, proposalID)
+
+		res = govHandler(ctx, gov.NewMsgVote(voterAddr, proposalID, gov.OptionYes))
+		require.True(t, res.IsOK(), "proposal %d: %s", i, res.Log)
+	}
+
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	gapp.Commit()
+
+	// execute the proposals once their voting period ends
+	header = abci.Header{Height: 3, Time: startTime.Add(gapp.govKeeper.GetVotingParams(ctx).VotingPeriod + time.Second)}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	gapp.Commit()
+
+	ctx = gapp.NewContext(true, header)
+	expectedStatus := []gov.ProposalStatus{gov.StatusPassed, gov.StatusPassed, gov.StatusFailed}
+	for i, proposalID := range proposalIDs {
+		proposal, ok := gapp.govKeeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		require.Equal(t, expectedStatus[i], proposal.Status, "proposal %d: %s", i, proposal.ExecResult.Log)
+	}
+	proposal, _ := gapp.govKeeper.GetProposal(ctx, proposalIDs[2])
+	require.Equal(t, gov.CodeInsufficientFunds, proposal.ExecResult.Code)
+
+	// the minted tokens are owned by the governance module account apart from
+	// the deposits, and only they can be spent
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("gov", 1200)), gapp.govKeeper.GetFunds(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("gov", 1200)).Add(halfDeposit), gapp.govKeeper.GetGovernanceAccount(ctx).GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("gov", 300)), gapp.accountKeeper.GetAccount(ctx, recipientAddr).GetCoins())
+
+	invariantMsg, broken := gov.ModuleAccountInvariant(gapp.govKeeper)(ctx)
+	require.False(t, broken, invariantMsg)
+}
+
 func setGenesis(gapp *BarkisApp) error {
 
 	genesisState := simapp.NewDefaultGenesisState()
@@ -86,6 +86,11 @@ type BaseConfig struct {
 	// HaltHeight contains a non-zero height at which a node will gracefully halt
 	// and shutdown that can be used to assist upgrades and testing.
 	HaltHeight uint64 `mapstructure:"halt-height"`
+
+	// RewardLedgerInterval is the number of blocks between two records of the
+	// reward ledger, which indexes the rewards of validators and the community
+	// pool allocations for history queries. Zero disables the reward ledger.
+	RewardLedgerInterval int64 `mapstructure:"reward-ledger-interval"`
 }
 
 type UpgradeConfig struct {
@@ -94,6 +99,20 @@ type UpgradeConfig struct {
 	UpdateVotingPeriodHeight      int64 `mapstructure:"UpdateVotingPeriodHeight"`
 	UpdateTokenSymbolRulesHeight  int64 `mapstructure:"UpdateTokenSymbolRulesHeight"`
 	TokenDesLenLimitUpgradeHeight int64 `mapstructure:"TokenDesLenLimitUpgradeHeight"`
+	MemoRequiredUpgrade           int64 `mapstructure:"MemoRequiredUpgrade"`
+	VestingAccountUpgrade         int64 `mapstructure:"VestingAccountUpgrade"`
+	FeeTokenUpgrade               int64 `mapstructure:"FeeTokenUpgrade"`
+	FeeGrantUpgrade               int64 `mapstructure:"FeeGrantUpgrade"`
+	AuthzUpgrade                  int64 `mapstructure:"AuthzUpgrade"`
+	AutoRestakeUpgrade            int64 `mapstructure:"AutoRestakeUpgrade"`
+	WithdrawAllRewardsUpgrade     int64 `mapstructure:"WithdrawAllRewardsUpgrade"`
+	ContinuousFundUpgrade         int64 `mapstructure:"ContinuousFundUpgrade"`
+	WeightedVoteUpgrade           int64 `mapstructure:"WeightedVoteUpgrade"`
+	ExpeditedProposalUpgrade      int64 `mapstructure:"ExpeditedProposalUpgrade"`
+	ExecMsgsProposalUpgrade       int64 `mapstructure:"ExecMsgsProposalUpgrade"`
+	TransferDelegationUpgrade     int64 `mapstructure:"TransferDelegationUpgrade"`
+	GovVoteSlashingUpgrade        int64 `mapstructure:"GovVoteSlashingUpgrade"`
+	TombstoneUpgrade              int64 `mapstructure:"TombstoneUpgrade"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -127,8 +146,9 @@ func (c *AppConfig) GetMinGasPrices() sdk.DecCoins {
 func DefaultAppConfig() *AppConfig {
 	return &AppConfig{
 		BaseConfig: BaseConfig{
-			MinGasPrices: defaultMinGasPrices,
-			HaltHeight:   0,
+			MinGasPrices:         defaultMinGasPrices,
+			HaltHeight:           0,
+			RewardLedgerInterval: 0,
 		},
 		UpgradeConfig: UpgradeConfig{
 			RewardUpgrade:                 math.MaxInt64,
@@ -136,6 +156,20 @@ func DefaultAppConfig() *AppConfig {
 			UpdateVotingPeriodHeight:      math.MaxInt64,
 			UpdateTokenSymbolRulesHeight:  math.MaxInt64,
 			TokenDesLenLimitUpgradeHeight: math.MaxInt64,
+			MemoRequiredUpgrade:           math.MaxInt64,
+			VestingAccountUpgrade:         math.MaxInt64,
+			FeeTokenUpgrade:               math.MaxInt64,
+			FeeGrantUpgrade:               math.MaxInt64,
+			AuthzUpgrade:                  math.MaxInt64,
+			AutoRestakeUpgrade:            math.MaxInt64,
+			WithdrawAllRewardsUpgrade:     math.MaxInt64,
+			ContinuousFundUpgrade:         math.MaxInt64,
+			WeightedVoteUpgrade:           math.MaxInt64,
+			ExpeditedProposalUpgrade:      math.MaxInt64,
+			ExecMsgsProposalUpgrade:       math.MaxInt64,
+			TransferDelegationUpgrade:     math.MaxInt64,
+			GovVoteSlashingUpgrade:        math.MaxInt64,
+			TombstoneUpgrade:              math.MaxInt64,
 		},
 	}
 }
@@ -22,6 +22,11 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # and shutdown that can be used to assist upgrades and testing.
 halt-height = {{ .BaseConfig.HaltHeight }}
 
+# RewardLedgerInterval is the number of blocks between two records of the
+# reward ledger, which indexes the rewards of validators and the community
+# pool allocations for history queries. Zero disables the reward ledger.
+reward-ledger-interval = {{ .BaseConfig.RewardLedgerInterval }}
+
 [upgrade]
 # Upgrade to change reward rules
 RewardUpgrade = {{ .UpgradeConfig.RewardUpgrade }}
@@ -37,6 +42,48 @@ UpdateTokenSymbolRulesHeight = {{ .UpgradeConfig.UpdateTokenSymbolRulesHeight }}
 
 # Upgrade to change token description length limitation
 TokenDesLenLimitUpgradeHeight = {{ .UpgradeConfig.TokenDesLenLimitUpgradeHeight }}
+
+# Upgrade to support memo required receiving accounts
+MemoRequiredUpgrade = {{ .UpgradeConfig.MemoRequiredUpgrade }}
+
+# Upgrade to support creating vesting accounts after genesis
+VestingAccountUpgrade = {{ .UpgradeConfig.VestingAccountUpgrade }}
+
+# Upgrade to support paying fees in whitelisted asset tokens
+FeeTokenUpgrade = {{ .UpgradeConfig.FeeTokenUpgrade }}
+
+# Upgrade to support fee allowances paying the fees of another account
+FeeGrantUpgrade = {{ .UpgradeConfig.FeeGrantUpgrade }}
+
+# Upgrade to support authorizing accounts to execute msgs on behalf of others
+AuthzUpgrade = {{ .UpgradeConfig.AuthzUpgrade }}
+
+# Upgrade to support auto-restaking delegation rewards
+AutoRestakeUpgrade = {{ .UpgradeConfig.AutoRestakeUpgrade }}
+
+# Upgrade to support withdrawing all rewards and commission in a single msg
+WithdrawAllRewardsUpgrade = {{ .UpgradeConfig.WithdrawAllRewardsUpgrade }}
+
+# Upgrade to support continuous community pool funds
+ContinuousFundUpgrade = {{ .UpgradeConfig.ContinuousFundUpgrade }}
+
+# Upgrade to support weighted votes on governance proposals
+WeightedVoteUpgrade = {{ .UpgradeConfig.WeightedVoteUpgrade }}
+
+# Upgrade to support expedited governance proposals
+ExpeditedProposalUpgrade = {{ .UpgradeConfig.ExpeditedProposalUpgrade }}
+
+# Upgrade to support governance proposals executing msgs signed by the gov module account
+ExecMsgsProposalUpgrade = {{ .UpgradeConfig.ExecMsgsProposalUpgrade }}
+
+# Upgrade to support transferring delegations between delegators
+TransferDelegationUpgrade = {{ .UpgradeConfig.TransferDelegationUpgrade }}
+
+# Upgrade to support slashing validators missing governance votes
+GovVoteSlashingUpgrade = {{ .UpgradeConfig.GovVoteSlashingUpgrade }}
+
+# Upgrade to support permanently excluding tombstoned consensus keys
+TombstoneUpgrade = {{ .UpgradeConfig.TombstoneUpgrade }}
 `
 
 var configTemplate *template.Template
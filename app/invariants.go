@@ -0,0 +1,42 @@
+package app
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// InvariantResult is the result of an invariant checked by CheckInvariants
+type InvariantResult struct {
+	Route    string
+	Broken   bool
+	Message  string
+	Duration time.Duration
+}
+
+// CheckInvariants runs every invariant registered with the crisis keeper
+// against the loaded state, whatever their policy. Each invariant runs on its
+// own cache of the store, which is never written, so the state is left as is.
+func (app *BarkisApp) CheckInvariants() []InvariantResult {
+	height := app.LastBlockHeight()
+	sdk.GlobalUpgradeMgr.SetBlockHeight(height)
+	ctx := app.NewContext(true, abci.Header{Height: height})
+
+	routes := app.crisisKeeper.Routes()
+	results := make([]InvariantResult, len(routes))
+	for i, ir := range routes {
+		cacheCtx, _ := ctx.CacheContext()
+
+		start := time.Now()
+		res, broken := ir.Invar(cacheCtx)
+		results[i] = InvariantResult{
+			Route:    ir.FullRoute(),
+			Broken:   broken,
+			Message:  res,
+			Duration: time.Since(start),
+		}
+	}
+	return results
+}
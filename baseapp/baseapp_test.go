@@ -150,6 +150,21 @@ func TestAppVersionSetterGetter(t *testing.T) {
 	require.Equal(t, versionString, string(res.Value))
 }
 
+func TestQueryMinGasPrices(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewBaseApp(t.Name(), defaultLogger(), db, nil)
+	res := app.Query(abci.RequestQuery{Path: "app/min_gas_prices"})
+	require.True(t, res.IsOK())
+	require.Equal(t, "", string(res.Value))
+
+	app = NewBaseApp(t.Name(), defaultLogger(), db, nil, SetMinGasPrices("0.025stake,1.5uatom"))
+	res = app.Query(abci.RequestQuery{Path: "app/min_gas_prices"})
+	require.True(t, res.IsOK())
+	minGasPrices, err := sdk.ParseDecCoins(string(res.Value))
+	require.NoError(t, err)
+	require.Equal(t, app.minGasPrices, minGasPrices)
+}
+
 func TestLoadVersionInvalid(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOpt := SetPruning(store.PruneSyncable)
@@ -85,6 +85,7 @@ func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*RestServer)) *cobra.C
 
 			registerRoutesFn(rs)
 			rs.registerSwaggerUI()
+			rs.registerWebsocket(viper.GetInt(flags.FlagMaxWSConnections))
 
 			// Start the rest server and return error if one exists
 			err = rs.Start(
@@ -109,3 +110,13 @@ func (rs *RestServer) registerSwaggerUI() {
 	staticServer := http.FileServer(statikFS)
 	rs.Mux.PathPrefix("/swagger-ui/").Handler(http.StripPrefix("/swagger-ui/", staticServer))
 }
+
+// registerWebsocket registers the endpoint streaming the node events to the
+// websocket clients, at most maxOpen at a time. The type parameter selects the
+// committed transactions (tx) or the new blocks (block) and the query parameter
+// filters them by their events in the query format of the node, eg.
+// /websocket?type=tx&query=transfer.recipient='cosmos1...'
+func (rs *RestServer) registerWebsocket(maxOpen int) {
+	handler := newWSHandler(rs.CliCtx.Codec, rs.CliCtx.NodeURI, maxOpen, rs.log.With("module", "rest-websocket"))
+	rs.Mux.Handle("/websocket", handler).Methods("GET")
+}
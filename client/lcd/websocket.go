@@ -0,0 +1,286 @@
+package lcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/tendermint/tendermint/libs/log"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/barkisnet/barkis/codec"
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+const (
+	// WSEventTx is the type of the subscriptions to the committed transactions
+	WSEventTx = "tx"
+	// WSEventBlock is the type of the subscriptions to the new blocks
+	WSEventBlock = "block"
+	// WSEventError is the type of the messages reporting an event which could not be decoded
+	WSEventError = "error"
+
+	// number of events buffered for a subscriber, a subscriber which lets the
+	// buffer fill up is disconnected
+	wsBufferSize = 100
+
+	wsSubscribeTimeout = 10 * time.Second
+	wsWriteWait        = 10 * time.Second
+	wsPongWait         = 60 * time.Second
+	wsPingPeriod       = (wsPongWait * 9) / 10
+	wsMaxMessageSize   = 512
+)
+
+// WSMessage is a message streamed to the websocket subscribers
+type WSMessage struct {
+	Type  string          `json:"type"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// wsHandler serves the websocket subscriptions to the node events. Each
+// connection has its own subscription to the node, so that the subscriptions
+// are not limited by the number of subscriptions per client of the node. Each
+// one uses a subscription client of the node though, so the number of open
+// connections must stay well below the node max_subscription_clients, which
+// are shared with the other clients such as broadcast_tx_commit.
+type wsHandler struct {
+	cdc      *codec.Codec
+	nodeURI  string
+	log      log.Logger
+	upgrader websocket.Upgrader
+	conns    chan struct{} // semaphore of the open connections
+}
+
+func newWSHandler(cdc *codec.Codec, nodeURI string, maxOpen int, logger log.Logger) *wsHandler {
+	return &wsHandler{
+		cdc:     cdc,
+		nodeURI: nodeURI,
+		log:     logger,
+		upgrader: websocket.Upgrader{
+			// like the node websocket, allow the wallets served from any origin
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+		conns: make(chan struct{}, maxOpen),
+	}
+}
+
+// subscriptionQuery builds the node query of a subscription, given its type and
+// an optional query on the event attributes such as transfer.recipient='cosmos1...'
+func subscriptionQuery(eventType, query string) (string, error) {
+	var subscription string
+	switch eventType {
+	case WSEventTx, "":
+		subscription = fmt.Sprintf("%s='%s'", tmtypes.EventTypeKey, tmtypes.EventTx)
+	case WSEventBlock:
+		subscription = fmt.Sprintf("%s='%s'", tmtypes.EventTypeKey, tmtypes.EventNewBlock)
+	default:
+		return "", fmt.Errorf("invalid event type %s, must be either %s or %s", eventType, WSEventTx, WSEventBlock)
+	}
+
+	if query != "" {
+		subscription = fmt.Sprintf("%s AND %s", subscription, query)
+	}
+	if _, err := tmquery.New(subscription); err != nil {
+		return "", fmt.Errorf("invalid query %s: %v", query, err)
+	}
+	return subscription, nil
+}
+
+func (h *wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query, err := subscriptionQuery(r.FormValue("type"), r.FormValue("query"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case h.conns <- struct{}{}:
+		defer func() { <-h.conns }()
+	default:
+		http.Error(w, "too many websocket connections", http.StatusServiceUnavailable)
+		return
+	}
+
+	// subscribe before upgrading the connection so that the node errors are
+	// reported as such
+	node := rpcclient.NewHTTP(h.nodeURI, "/websocket")
+	if err := node.Start(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer node.Stop() // nolint: errcheck
+
+	ctx, cancel := context.WithTimeout(context.Background(), wsSubscribeTimeout)
+	defer cancel()
+	events, err := node.Subscribe(ctx, "rest-server", query, wsBufferSize)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to subscribe to the node: %v", err), http.StatusBadGateway)
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has already replied to the client
+		return
+	}
+	defer conn.Close()
+
+	h.log.Info("websocket subscription", "remote", r.RemoteAddr, "query", query)
+	h.serve(conn, node, events)
+}
+
+// serve streams the events of a subscription to a websocket client until it
+// disconnects or falls behind
+func (h *wsHandler) serve(conn *websocket.Conn, node rpcclient.Client, events <-chan ctypes.ResultEvent) {
+	// the client only sends pongs and close messages
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		conn.SetReadLimit(wsMaxMessageSize)
+		conn.SetReadDeadline(time.Now().Add(wsPongWait)) // nolint: errcheck
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		})
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	// buffer the events without ever blocking, the node drops the events of a
+	// subscription which is not read fast enough. The buffer is closed once the
+	// node ends the subscription.
+	buffer := make(chan ctypes.ResultEvent, wsBufferSize)
+	overflow := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					close(buffer)
+					return
+				}
+				select {
+				case buffer <- event:
+				default:
+					close(overflow)
+					return
+				}
+			case <-closed:
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	encoder := wsEncoder{cdc: h.cdc, node: node}
+	for {
+		select {
+		case event, ok := <-buffer:
+			if !ok {
+				h.log.Info("websocket subscription ended by the node", "remote", conn.RemoteAddr())
+				msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "subscription ended")
+				conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsWriteWait)) // nolint: errcheck
+				return
+			}
+			msg, err := encoder.encode(event)
+			if err != nil {
+				h.log.Error("failed to decode event", "query", event.Query, "err", err)
+				msg = WSMessage{Type: WSEventError, Error: err.Error()}
+			}
+			bz, err := json.Marshal(msg)
+			if err != nil {
+				h.log.Error("failed to encode event", "query", event.Query, "err", err)
+				continue
+			}
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait)) // nolint: errcheck
+			if err := conn.WriteMessage(websocket.TextMessage, bz); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait)) // nolint: errcheck
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+
+		case <-overflow:
+			h.log.Info("websocket subscriber too slow, disconnecting", "remote", conn.RemoteAddr())
+			msg := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "too slow, events dropped")
+			conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsWriteWait)) // nolint: errcheck
+			return
+
+		case <-closed:
+			return
+		}
+	}
+}
+
+// wsEncoder decodes the node events into the messages of the subscribers
+type wsEncoder struct {
+	cdc  *codec.Codec
+	node rpcclient.Client
+
+	// time of the block of the last transaction
+	height    int64
+	timestamp string
+}
+
+func (e *wsEncoder) encode(event ctypes.ResultEvent) (WSMessage, error) {
+	switch data := event.Data.(type) {
+	case tmtypes.EventDataTx:
+		txResponse, err := e.encodeTx(data)
+		if err != nil {
+			return WSMessage{}, err
+		}
+		bz, err := e.cdc.MarshalJSON(txResponse)
+		if err != nil {
+			return WSMessage{}, err
+		}
+		return WSMessage{Type: WSEventTx, Data: bz}, nil
+
+	case tmtypes.EventDataNewBlock:
+		bz, err := e.cdc.MarshalJSON(data.Block.Header)
+		if err != nil {
+			return WSMessage{}, err
+		}
+		return WSMessage{Type: WSEventBlock, Data: bz}, nil
+
+	default:
+		return WSMessage{}, fmt.Errorf("unexpected event data %T", event.Data)
+	}
+}
+
+func (e *wsEncoder) encodeTx(data tmtypes.EventDataTx) (sdk.TxResponse, error) {
+	var tx sdk.Tx
+	if err := e.cdc.UnmarshalBinaryLengthPrefixed(data.Tx, &tx); err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	if data.Height != e.height {
+		resBlock, err := e.node.Block(&data.Height)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+		e.height, e.timestamp = data.Height, resBlock.Block.Time.Format(time.RFC3339)
+	}
+
+	resTx := &ctypes.ResultTx{
+		Hash:     data.Tx.Hash(),
+		Height:   data.Height,
+		Index:    data.Index,
+		TxResult: data.Result,
+		Tx:       data.Tx,
+	}
+	return sdk.NewResponseResultTx(resTx, tx, e.timestamp), nil
+}
@@ -0,0 +1,74 @@
+package lcd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/barkisnet/barkis/codec"
+)
+
+func TestSubscriptionQuery(t *testing.T) {
+	tests := []struct {
+		eventType, query string
+		expected         string
+		expectErr        bool
+	}{
+		{"", "", "tm.event='Tx'", false},
+		{WSEventTx, "transfer.recipient='cosmos1abc'", "tm.event='Tx' AND transfer.recipient='cosmos1abc'", false},
+		{WSEventBlock, "", "tm.event='NewBlock'", false},
+		{WSEventTx, "transfer.recipient=cosmos1abc", "", true},
+		{"vote", "", "", true},
+	}
+	for _, tt := range tests {
+		query, err := subscriptionQuery(tt.eventType, tt.query)
+		if tt.expectErr {
+			require.Error(t, err, "%+v", tt)
+			continue
+		}
+		require.NoError(t, err, "%+v", tt)
+		require.Equal(t, tt.expected, query)
+	}
+}
+
+func TestWSHandlerRejections(t *testing.T) {
+	// invalid queries are rejected before the connection is counted
+	handler := newWSHandler(codec.New(), "tcp://localhost:26657", 0, log.NewNopLogger())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/websocket?type=vote", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// no connection is accepted once the limit is reached
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/websocket?type=tx", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestWSHandlerSubscriptionEnded(t *testing.T) {
+	// the node ends the subscription
+	events := make(chan ctypes.ResultEvent)
+	close(events)
+
+	handler := newWSHandler(codec.New(), "tcp://localhost:26657", 1, log.NewNopLogger())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := handler.upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		handler.serve(conn, nil, events)
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the client is disconnected with a normal closure
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), "%v", err)
+}
@@ -94,6 +94,7 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		rpc.BlockResultsCommand(),
 		authcmd.QueryTxsByEventsCmd(cdc),
 		authcmd.QueryTxCmd(cdc),
+		authcmd.QueryAccountHistoryCmd(cdc),
 		client.LineBreak,
 	)
 
@@ -118,6 +119,10 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 		authcmd.GetBroadcastCommand(cdc),
 		authcmd.GetEncodeCommand(cdc),
 		client.LineBreak,
+		authcmd.GetBundleCommand(cdc),
+		authcmd.GetSignBatchCommand(cdc),
+		authcmd.GetBroadcastBatchCommand(cdc),
+		client.LineBreak,
 	)
 
 	// add modules' tx commands
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/barkisnet/barkis/app"
+	"github.com/barkisnet/barkis/app/config"
+	"github.com/barkisnet/barkis/client/flags"
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+func checkInvariantsCmd(ctx *config.ServerContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "check-invariants [height]",
+		Short: "Check every registered invariant against the state at a given height",
+		Long: `Load the application state at the given height, or at the latest height if
+none is given, and run every invariant registered with the crisis module against
+it, whatever their policy. The application database is opened read-only, and the
+reward ledger is not written, so the data directory is left as is. The node must
+be stopped, and the height must not have been pruned.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height := int64(-1)
+			if len(args) == 1 {
+				var err error
+				height, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil || height <= 0 {
+					return fmt.Errorf("invalid height %s, must be a positive integer", args[0])
+				}
+			}
+
+			cfg := ctx.Config
+			cfg.SetRoot(viper.GetString(flags.FlagHome))
+
+			db, err := openReadOnlyDB("application", filepath.Join(cfg.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// loading the app must not record anything in the reward ledger
+			app.BarkisContext.RewardLedgerInterval = 0
+
+			gApp := app.NewBarkisApp(ctx.Logger, db, nil, height == -1, uint(0))
+			if height != -1 {
+				if err := gApp.LoadHeight(height); err != nil {
+					return fmt.Errorf("failed to load the state at height %d, it may have been pruned: %v", height, err)
+				}
+			}
+			if gApp.LastBlockHeight() == 0 {
+				return fmt.Errorf("state is not initialized")
+			}
+
+			fmt.Printf("checking invariants at height %d\n", gApp.LastBlockHeight())
+			broken := 0
+			for _, res := range gApp.CheckInvariants() {
+				if res.Broken {
+					broken++
+					fmt.Printf("%s: broken (%s)\n%s\n", res.Route, res.Duration, res.Message)
+				} else {
+					fmt.Printf("%s: ok (%s)\n", res.Route, res.Duration)
+				}
+			}
+
+			if broken > 0 {
+				return fmt.Errorf("%d invariant(s) broken at height %d", broken, gApp.LastBlockHeight())
+			}
+			return nil
+		},
+	}
+}
+
+// openReadOnlyDB opens a goleveldb database read-only, so that loading the app
+// cannot alter the state of the node
+func openReadOnlyDB(name, dir string) (dbm.DB, error) {
+	if sdk.DBBackend == string(dbm.CLevelDBBackend) {
+		return nil, fmt.Errorf("the %s backend can't be opened read-only", dbm.CLevelDBBackend)
+	}
+	return dbm.NewGoLevelDBWithOpts(name, dir, &opt.Options{ReadOnly: true})
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"runtime/pprof"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/tendermint/tendermint/abci/server"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -101,6 +103,7 @@ func startStandAlone(ctx *config.ServerContext, appCreator AppCreator) error {
 		if err != nil {
 			cmn.Exit(err.Error())
 		}
+		closeApp(ctx, app)
 	})
 
 	// run forever (the node will not be returned)
@@ -175,6 +178,8 @@ func startInProcess(ctx *config.ServerContext, appCreator AppCreator) (*node.Nod
 			_ = tmNode.Stop()
 		}
 
+		closeApp(ctx, app)
+
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
@@ -185,3 +190,14 @@ func startInProcess(ctx *config.ServerContext, appCreator AppCreator) (*node.Nod
 	// run forever (the node will not be returned)
 	select {}
 }
+
+// closeApp closes the resources held by the app once it is stopped, if it has any
+func closeApp(ctx *config.ServerContext, app abci.Application) {
+	closer, ok := app.(io.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		ctx.Logger.Error("failed to close the app", "err", err)
+	}
+}
@@ -147,6 +147,7 @@ func GenAuthGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 				return v
 			}(r),
 		),
+		auth.FeeTokens{},
 	)
 
 	fmt.Printf("Selected randomly generated auth parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, authGenesis.Params))
@@ -164,6 +165,7 @@ func GenBankGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 				})
 			return v
 		}(r),
+		nil,
 	)
 
 	fmt.Printf("Selected randomly generated bank parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, bankGenesis))
@@ -466,6 +468,9 @@ func GenStakingGenesisState(
 			}(r),
 			7,
 			sdk.DefaultBondDenom,
+			staking.DefaultMinSelfBond,
+			staking.DefaultMinCommissionRate,
+			staking.DefaultMaxCommissionRate,
 		),
 		nil,
 		nil,
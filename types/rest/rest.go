@@ -62,6 +62,7 @@ type BaseReq struct {
 	BroadcastMode string       `json:"broadcast_mode"`
 	Simulate      bool         `json:"simulate"`
 	GenerateOnly  bool         `json:"generate_only"`
+	FeePayer      string       `json:"fee_payer"`
 }
 
 // NewBaseReq creates a new basic request instance and sanitizes its values
@@ -89,10 +90,12 @@ func NewBaseReq(
 
 // Sanitize performs basic sanitization on a BaseReq object.
 func (br BaseReq) Sanitize() BaseReq {
-	return NewBaseReq(
+	sanitized := NewBaseReq(
 		br.From, br.Password, br.Memo, br.ChainID, br.Gas, br.GasAdjustment,
 		br.AccountNumber, br.Sequence, br.Fees, br.GasPrices, br.BroadcastMode, br.Simulate, br.GenerateOnly,
 	)
+	sanitized.FeePayer = strings.TrimSpace(br.FeePayer)
+	return sanitized
 }
 
 // ValidateBasic performs basic validation of a BaseReq. If custom validation
@@ -8,6 +8,20 @@ const (
 	UpdateVotingPeriodHeight      = "UpdateVotingPeriodHeight"
 	UpdateTokenSymbolRulesHeight  = "UpdateTokenSymbolRulesHeight"
 	TokenDesLenLimitUpgradeHeight = "TokenDesLenLimitUpgradeHeight"
+	MemoRequiredUpgrade           = "MemoRequiredUpgrade"
+	VestingAccountUpgrade         = "VestingAccountUpgrade"
+	FeeTokenUpgrade               = "FeeTokenUpgrade"
+	FeeGrantUpgrade               = "FeeGrantUpgrade"
+	AuthzUpgrade                  = "AuthzUpgrade"
+	AutoRestakeUpgrade            = "AutoRestakeUpgrade"
+	WithdrawAllRewardsUpgrade     = "WithdrawAllRewardsUpgrade"
+	ContinuousFundUpgrade         = "ContinuousFundUpgrade"
+	WeightedVoteUpgrade           = "WeightedVoteUpgrade"
+	ExpeditedProposalUpgrade      = "ExpeditedProposalUpgrade"
+	ExecMsgsProposalUpgrade       = "ExecMsgsProposalUpgrade"
+	TransferDelegationUpgrade     = "TransferDelegationUpgrade"
+	GovVoteSlashingUpgrade        = "GovVoteSlashingUpgrade"
+	TombstoneUpgrade              = "TombstoneUpgrade"
 )
 
 var GlobalUpgradeMgr = NewUpgradeManager()
@@ -55,7 +55,7 @@ func SetupTestInput() (*codec.Codec, sdk.Context, Keeper, auth.AccountKeeper, ba
 
 	paramKeeper := params.NewKeeper(cdc, paramsKey, tParamsKey, params.DefaultCodespace)
 	accountKeeper := auth.NewAccountKeeper(cdc, authKey, paramKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
-	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	bankKeeper := bank.NewBaseKeeper(sdk.NewKVStoreKey(bank.StoreKey), accountKeeper, paramKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
 	accountKeeper.SetParams(ctx, auth.DefaultParams())
 
 	maccPerms := map[string][]string{
@@ -33,6 +33,8 @@ var (
 	NewContinuousVestingAccount    = types.NewContinuousVestingAccount
 	NewDelayedVestingAccountRaw    = types.NewDelayedVestingAccountRaw
 	NewDelayedVestingAccount       = types.NewDelayedVestingAccount
+	NewPeriodicVestingAccountRaw   = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount      = types.NewPeriodicVestingAccount
 	RegisterCodec                  = types.RegisterCodec
 	NewGenesisState                = types.NewGenesisState
 	DefaultGenesisState            = types.DefaultGenesisState
@@ -52,6 +54,7 @@ var (
 	NewTxBuilderFromCLI            = types.NewTxBuilderFromCLI
 	MakeSignature                  = types.MakeSignature
 	NewAccountRetriever            = types.NewAccountRetriever
+	NewFeeToken                    = types.NewFeeToken
 
 	// variable aliases
 	ModuleCdc                 = types.ModuleCdc
@@ -62,6 +65,7 @@ var (
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
 	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
 	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	KeyFeeTokens              = types.KeyFeeTokens
 )
 
 type (
@@ -71,8 +75,13 @@ type (
 	BaseVestingAccount       = types.BaseVestingAccount
 	ContinuousVestingAccount = types.ContinuousVestingAccount
 	DelayedVestingAccount    = types.DelayedVestingAccount
+	PeriodicVestingAccount   = types.PeriodicVestingAccount
+	Period                   = types.Period
+	Periods                  = types.Periods
 	GenesisState             = types.GenesisState
 	Params                   = types.Params
+	FeeToken                 = types.FeeToken
+	FeeTokens                = types.FeeTokens
 	QueryAccountParams       = types.QueryAccountParams
 	StdSignMsg               = types.StdSignMsg
 	StdTx                    = types.StdTx
@@ -36,6 +36,16 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig []byte, pub
 // numbers, checks signatures & account numbers, and deducts fees from the first
 // signer.
 func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
+	return NewAnteHandlerWithFeeGrant(ak, supplyKeeper, nil, nil, sigGasConsumer)
+}
+
+// NewAnteHandlerWithFeeGrant returns an AnteHandler like NewAnteHandler which
+// additionally lets the fee payer of a transaction pay its fees out of a fee
+// allowance granted to the first signer.
+func NewAnteHandlerWithFeeGrant(ak AccountKeeper, supplyKeeper types.SupplyKeeper,
+	feeGrantKeeper types.FeeGrantKeeper, stakingKeeper types.StakingKeeper,
+	sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
+
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
 	) (newCtx sdk.Context, res sdk.Result, abort bool) {
@@ -55,11 +65,23 @@ func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasCon
 
 		params := ak.GetParams(ctx)
 
+		// Once fee tokens are supported, fees may only be paid in bond denom or
+		// in whitelisted fee tokens, which count for their bond denom equivalent.
+		mempoolFee := stdTx.Fee
+		if sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.FeeTokenUpgrade) {
+			feeTokens := ak.GetFeeTokens(ctx)
+			bondDenom := getBondDenom(ctx, stakingKeeper)
+			if res := ValidateFeeTokens(stdTx.Fee, feeTokens, bondDenom); !res.IsOK() {
+				return newCtx, res, true
+			}
+			mempoolFee = NewStdFee(stdTx.Fee.Gas, feeTokens.ConvertToBondDenom(stdTx.Fee.Amount, bondDenom))
+		}
+
 		// Ensure that the provided fees meet a minimum threshold for the validator,
 		// if this is a CheckTx. This is only for local mempool purposes, and thus
 		// is only ran on check tx.
 		if ctx.IsCheckTx() && !simulate {
-			res := EnsureSufficientMempoolFees(ctx, stdTx.Fee)
+			res := EnsureSufficientMempoolFees(ctx, mempoolFee)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
@@ -116,9 +138,16 @@ func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasCon
 			return newCtx, res, true
 		}
 
+		// fetch the fee payer, which is the first signer unless the fees are
+		// paid out of a fee allowance
+		feePayerAcc, res := GetFeePayerAcc(newCtx, ak, feeGrantKeeper, stdTx.Fee, signerAccs[0])
+		if !res.IsOK() {
+			return newCtx, res, true
+		}
+
 		// deduct the fees
 		if !stdTx.Fee.Amount.IsZero() {
-			res = DeductFees(supplyKeeper, newCtx, signerAccs[0], stdTx.Fee.Amount)
+			res = DeductFees(supplyKeeper, newCtx, feePayerAcc, stdTx.Fee.Amount)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
@@ -127,6 +156,14 @@ func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasCon
 			signerAccs[0] = ak.GetAccount(newCtx, signerAccs[0].GetAddress())
 		}
 
+		// charge the fees against the fee allowance once they are deducted, so
+		// that the allowance is left untouched if the fee payer cannot pay them
+		if !feePayerAcc.GetAddress().Equals(signerAccs[0].GetAddress()) {
+			if err := feeGrantKeeper.UseGrantedFees(newCtx, feePayerAcc.GetAddress(), signerAccs[0].GetAddress(), stdTx.Fee.Amount); err != nil {
+				return newCtx, err.Result(), true
+			}
+		}
+
 		// stdSigs contains the sequence number, account number, and signatures.
 		// When simulating, this would just be a 0-length slice.
 		stdSigs := stdTx.GetSignatures()
@@ -164,6 +201,29 @@ func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (Accou
 	return nil, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", addr)).Result()
 }
 
+// GetFeePayerAcc returns the account paying the fees of a transaction. If the
+// fee sets a fee payer other than the first signer, the fees are to be charged
+// against the fee allowance the fee payer granted to the first signer, once
+// they are deducted.
+func GetFeePayerAcc(ctx sdk.Context, ak AccountKeeper, feeGrantKeeper types.FeeGrantKeeper,
+	stdFee StdFee, signerAcc Account) (Account, sdk.Result) {
+
+	if stdFee.FeePayer.Empty() || stdFee.FeePayer.Equals(signerAcc.GetAddress()) {
+		return signerAcc, sdk.Result{}
+	}
+
+	if feeGrantKeeper == nil || !sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.FeeGrantUpgrade) {
+		return nil, sdk.ErrUnauthorized("fee payer other than the first signer is not supported").Result()
+	}
+
+	feePayerAcc := ak.GetAccount(ctx, stdFee.FeePayer)
+	if feePayerAcc == nil {
+		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("fee payer %s does not exist", stdFee.FeePayer)).Result()
+	}
+
+	return feePayerAcc, sdk.Result{}
+}
+
 // ValidateSigCount validates that the transaction has a valid cumulative total
 // amount of signatures.
 func ValidateSigCount(stdTx StdTx, params Params) sdk.Result {
@@ -329,6 +389,28 @@ func consumeMultisignatureVerificationGas(meter sdk.GasMeter,
 	}
 }
 
+// getBondDenom returns the bond denom of the staking keeper, or the default
+// bond denom if there is no staking keeper.
+func getBondDenom(ctx sdk.Context, stakingKeeper types.StakingKeeper) string {
+	if stakingKeeper == nil {
+		return sdk.DefaultBondDenom
+	}
+	return stakingKeeper.BondDenom(ctx)
+}
+
+// ValidateFeeTokens validates that the fee of a transaction is only paid in
+// bond denom or in whitelisted fee tokens.
+func ValidateFeeTokens(stdFee StdFee, feeTokens types.FeeTokens, bondDenom string) sdk.Result {
+	if !feeTokens.IsAccepted(stdFee.Amount, bondDenom) {
+		return sdk.ErrInsufficientFee(
+			fmt.Sprintf("fees must be paid in %s or in one of the fee tokens [%s]; got: %q",
+				bondDenom, feeTokens, stdFee.Amount),
+		).Result()
+	}
+
+	return sdk.Result{}
+}
+
 // DeductFees deducts fees from the given account.
 //
 // NOTE: We could use the CoinKeeper (in addition to the AccountKeeper, because
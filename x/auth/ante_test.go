@@ -820,3 +820,55 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
+
+func TestValidateFeeTokens(t *testing.T) {
+	feeTokens := FeeTokens{NewFeeToken("btc", sdk.NewDec(2))}
+
+	testCases := []struct {
+		input      StdFee
+		bondDenom  string
+		expectedOK bool
+	}{
+		{NewStdFee(200000, sdk.Coins{}), sdk.DefaultBondDenom, true},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2))), sdk.DefaultBondDenom, true},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("btc", 2))), sdk.DefaultBondDenom, true},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("photino", 2))), sdk.DefaultBondDenom, false},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("btc", 2), sdk.NewInt64Coin("photino", 2))), sdk.DefaultBondDenom, false},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("photino", 2))), "photino", true},
+		{NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2))), "photino", false},
+	}
+
+	for i, tc := range testCases {
+		res := ValidateFeeTokens(tc.input, feeTokens, tc.bondDenom)
+		require.Equal(
+			t, tc.expectedOK, res.IsOK(),
+			"unexpected result; tc #%d, input: %v, log: %v", i, tc.input, res.Log,
+		)
+	}
+}
+
+func TestGetFeePayerAcc(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	_, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	input.ak.SetAccount(ctx, acc1)
+
+	fee := types.NewTestStdFee()
+
+	// the signer pays when no fee payer is set
+	payer, res := GetFeePayerAcc(ctx, input.ak, nil, fee, acc1)
+	require.True(t, res.IsOK())
+	require.Equal(t, acc1, payer)
+
+	// the signer pays when it is the fee payer
+	payer, res = GetFeePayerAcc(ctx, input.ak, nil, fee.WithFeePayer(addr1), acc1)
+	require.True(t, res.IsOK())
+	require.Equal(t, acc1, payer)
+
+	// another fee payer requires fee grants
+	_, res = GetFeePayerAcc(ctx, input.ak, nil, fee.WithFeePayer(addr2), acc1)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+}
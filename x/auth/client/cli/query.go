@@ -16,16 +16,13 @@ import (
 	"github.com/barkisnet/barkis/version"
 	"github.com/barkisnet/barkis/x/auth/client/utils"
 	"github.com/barkisnet/barkis/x/auth/types"
-
-	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 const (
-	flagEvents = "events"
-	flagPage   = "page"
-	flagLimit  = "limit"
-
-	eventFormat = "{eventType}.{eventAttribute}={value}"
+	flagEvents  = "events"
+	flagOrderBy = "order-by"
+	flagPage    = "page"
+	flagLimit   = "limit"
 )
 
 // GetQueryCmd returns the transaction commands for this module
@@ -38,7 +35,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(GetAccountCmd(cdc))
+	cmd.AddCommand(
+		GetAccountCmd(cdc),
+		GetFeeTokensCmd(cdc),
+		GetMinGasPricesCmd(cdc),
+	)
 
 	return cmd
 }
@@ -71,6 +72,56 @@ func GetAccountCmd(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 }
 
+// GetFeeTokensCmd returns a query of the tokens which are accepted to pay fees
+// along with their exchange rate to the bond denom.
+func GetFeeTokensCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-tokens",
+		Short: "Query the tokens accepted to pay fees and their exchange rates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeTokens)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var feeTokens types.FeeTokens
+			cdc.MustUnmarshalJSON(res, &feeTokens)
+			return cliCtx.PrintOutput(feeTokens)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetMinGasPricesCmd returns a query of the minimum gas prices of the node.
+func GetMinGasPricesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-gas-prices",
+		Short: "Query the minimum gas prices under which the node rejects transactions",
+		Long: strings.TrimSpace(`Query the minimum gas prices of the node, under which it does not
+accept transactions in its mempool. They are set in the configuration of each
+node, so other nodes may have different ones. Fees can be computed from them
+with the --fees=auto flag of the transaction commands.`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			minGasPrices, err := utils.QueryMinGasPrices(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(minGasPrices)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -78,49 +129,109 @@ func QueryTxsByEventsCmd(cdc *codec.Codec) *cobra.Command {
 		Short: "Query for paginated transactions that match a set of events",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
-Search for transactions that match the exact given events where results are paginated.
-Each event takes the form of '%s'. Please refer
-to each module's documentation for the full set of events to query for. Each module
-documents its respective events under 'xx_events.md'.
+Search for transactions that match the given events where results are paginated.
+Each event takes the form of '%s' where the operator is one of
+'=', '>', '>=', '<' or '<='. The comparison operators only accept numbers and
+'{key}={min}..{max}' matches an inclusive range of numbers, eg. of heights with
+the 'tx.height' key. Events joined with '&' must all match, and groups of events
+joined with '|' match if any of them matches. Please refer to each module's
+documentation for the full set of events to query for. Each module documents its
+respective events under 'xx_events.md'.
+
+Transactions are ordered by height, from the oldest to the newest unless
+--%s=%s is given.
 
 Example:
 $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator_reward' --page 1 --limit 30
-`, eventFormat, version.ClientName, flagEvents),
+$ %s query txs --%s 'transfer.recipient=cosmos1...&tx.height>=1000|transfer.sender=cosmos1...&tx.height=1000..2000' --%s=%s
+`, utils.EventFormat, flagOrderBy, utils.OrderDesc,
+				version.ClientName, flagEvents,
+				version.ClientName, flagEvents, flagOrderBy, utils.OrderDesc),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			eventsStr := strings.Trim(viper.GetString(flagEvents), "'")
+			groups, err := utils.ParseEvents(viper.GetString(flagEvents))
+			if err != nil {
+				return err
+			}
+
+			orderBy := viper.GetString(flagOrderBy)
+			if err := utils.ValidateOrderBy(orderBy); err != nil {
+				return err
+			}
+
+			page := viper.GetInt(flagPage)
+			limit := viper.GetInt(flagLimit)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txs, err := utils.SearchTxs(cliCtx, groups, page, limit, orderBy)
+			if err != nil {
+				return err
+			}
 
-			var events []string
-			if strings.Contains(eventsStr, "&") {
-				events = strings.Split(eventsStr, "&")
+			var output []byte
+			if cliCtx.Indent {
+				output, err = cdc.MarshalJSONIndent(txs, "", "  ")
 			} else {
-				events = append(events, eventsStr)
+				output, err = cdc.MarshalJSON(txs)
+			}
+
+			if err != nil {
+				return err
 			}
 
-			var tmEvents []string
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+
+	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
+	viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
+
+	cmd.Flags().String(flagEvents, "", fmt.Sprintf("list of transaction events in the form of %s", utils.EventFormat))
+	cmd.Flags().String(flagOrderBy, utils.OrderAsc, fmt.Sprintf("Order of the transactions by height, either %s or %s", utils.OrderAsc, utils.OrderDesc))
+	cmd.Flags().Uint32(flagPage, rest.DefaultPage, "Query a specific page of paginated results")
+	cmd.Flags().Uint32(flagLimit, rest.DefaultLimit, "Query number of transactions results per page returned")
+	cmd.MarkFlagRequired(flagEvents)
 
-			for _, event := range events {
-				if !strings.Contains(event, "=") {
-					return fmt.Errorf("invalid event; event %s should be of the format: %s", event, eventFormat)
-				} else if strings.Count(event, "=") > 1 {
-					return fmt.Errorf("invalid event; event %s should be of the format: %s", event, eventFormat)
-				}
+	return cmd
+}
+
+// QueryAccountHistoryCmd returns a command to search through the transactions
+// an account took part in.
+func QueryAccountHistoryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-history [address]",
+		Short: "Query for paginated transactions sent or received by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Search for the transactions an account took part in, whether it signed them or
+it received coins or delegations from them. Transactions are ordered by height,
+from the newest to the oldest unless --%s=%s is given.
 
-				tokens := strings.Split(event, "=")
-				if tokens[0] == tmtypes.TxHeightKey {
-					event = fmt.Sprintf("%s=%s", tokens[0], tokens[1])
-				} else {
-					event = fmt.Sprintf("%s='%s'", tokens[0], tokens[1])
-				}
+Example:
+$ %s query account-history cosmos1... --page 1 --limit 30
+`, flagOrderBy, utils.OrderAsc, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
 
-				tmEvents = append(tmEvents, event)
+			orderBy := viper.GetString(flagOrderBy)
+			if err := utils.ValidateOrderBy(orderBy); err != nil {
+				return err
 			}
 
 			page := viper.GetInt(flagPage)
 			limit := viper.GetInt(flagLimit)
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			txs, err := utils.QueryTxsByEvents(cliCtx, tmEvents, page, limit)
+			txs, err := utils.QueryAccountHistory(cliCtx, addr, nil, page, limit, orderBy)
 			if err != nil {
 				return err
 			}
@@ -147,10 +258,9 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
 	viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
 
-	cmd.Flags().String(flagEvents, "", fmt.Sprintf("list of transaction events in the form of %s", eventFormat))
+	cmd.Flags().String(flagOrderBy, utils.OrderDesc, fmt.Sprintf("Order of the transactions by height, either %s or %s", utils.OrderAsc, utils.OrderDesc))
 	cmd.Flags().Uint32(flagPage, rest.DefaultPage, "Query a specific page of paginated results")
 	cmd.Flags().Uint32(flagLimit, rest.DefaultLimit, "Query number of transactions results per page returned")
-	cmd.MarkFlagRequired(flagEvents)
 
 	return cmd
 }
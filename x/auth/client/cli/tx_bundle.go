@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/barkisnet/barkis/client/context"
+	"github.com/barkisnet/barkis/client/flags"
+	"github.com/barkisnet/barkis/codec"
+	"github.com/barkisnet/barkis/x/auth/client/utils"
+	"github.com/barkisnet/barkis/x/auth/types"
+)
+
+// GetBundleCommand returns the command bundling transactions generated offline
+// with what is needed to sign them offline.
+func GetBundleCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle [file...]",
+		Short: "Bundle transactions generated offline to sign them offline",
+		Long: strings.TrimSpace(`Bundle transactions created with the --generate-only flag
+with the chain ID, and the account number and sequence of each of their signers,
+so that they can be signed by the sign-batch command on a machine which cannot
+reach a node. The bundles are printed as a batch, in the order of the files.
+
+The account numbers and sequences are queried from a node, the sequences of a
+signer being consecutive over the transactions. With the --offline flag, the
+transactions must all have the same single signer whose account number and first
+sequence are given by the --account-number and --sequence flags.
+
+$ <appcli> tx bundle ./tx1.json ./tx2.json --chain-id=barkis > batch.json
+`),
+		PreRun: preSignCmd,
+		Args:   cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := types.NewTxBuilderFromCLI()
+
+			txs := make([]types.StdTx, len(args))
+			for i, filename := range args {
+				stdTx, err := utils.ReadStdTxFromFile(cdc, filename)
+				if err != nil {
+					return err
+				}
+				txs[i] = stdTx
+			}
+
+			bundles, err := utils.BuildTxBundles(txBldr, cliCtx, txs, viper.GetBool(flagOffline))
+			if err != nil {
+				return err
+			}
+
+			return writeTxBundles(cdc, cliCtx.Indent, bundles)
+		},
+	}
+
+	cmd.Flags().Bool(
+		flagOffline, false,
+		"Offline mode; Do not query a full node. The --account-number and --sequence flags are required",
+	)
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// GetSignBatchCommand returns the command signing a batch of transaction
+// bundles offline.
+func GetSignBatchCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-batch [file]",
+		Short: "Sign a batch of transaction bundles offline",
+		Long: strings.TrimSpace(`Sign the transaction bundles of [file], created with the
+bundle command, which the --from key is a signer of. The bundles carry the chain
+ID, account numbers and sequences, so that no node is queried. The batch is
+printed with the new signatures, in place of the missing ones; transactions with
+several signers are fully signed once every signer signed the batch.
+
+$ <appcli> tx sign-batch ./batch.json --from=mykey > signed.json
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bundles, err := utils.ReadTxBundlesFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := types.NewTxBuilderFromCLI()
+
+			bundles, signed, err := utils.SignTxBundles(txBldr, cliCtx.GetFromName(), bundles)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "signed %d of %d transactions\n", signed, len(bundles))
+
+			return writeTxBundles(cdc, cliCtx.Indent, bundles)
+		},
+	}
+
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+
+	cmd = flags.PostCommands(cmd)[0]
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetBroadcastBatchCommand returns the command broadcasting a batch of signed
+// transaction bundles.
+func GetBroadcastBatchCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast-batch [file]",
+		Short: "Broadcast a batch of signed transaction bundles",
+		Long: strings.TrimSpace(`Broadcast the transaction bundles of [file], signed with the
+sign-batch command, so that the sequences of each signer increase, and print the
+result of each one. The signatures are verified before any bundle is broadcast. A
+failed transaction stops the broadcast of the following bundles of its signers,
+which are reported as skipped. If you supply a dash (-) argument in place of an
+input filename, the command reads from standard input.
+
+$ <appcli> tx broadcast-batch ./signed.json
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bundles, err := utils.ReadTxBundlesFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			results, err := utils.BroadcastTxBundles(cliCtx, bundles)
+			if err != nil {
+				return err
+			}
+
+			if err := cliCtx.PrintOutput(results); err != nil {
+				return err
+			}
+			if results.Failed() {
+				return fmt.Errorf("not every transaction was broadcast successfully")
+			}
+			return nil
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func writeTxBundles(cdc *codec.Codec, indent bool, bundles types.TxBundles) error {
+	var (
+		json []byte
+		err  error
+	)
+	if indent {
+		json, err = cdc.MarshalJSONIndent(bundles, "", "  ")
+	} else {
+		json, err = cdc.MarshalJSON(bundles)
+	}
+	if err != nil {
+		return err
+	}
+
+	if viper.GetString(flagOutfile) == "" {
+		fmt.Printf("%s\n", json)
+		return nil
+	}
+
+	fp, err := os.OpenFile(
+		viper.GetString(flagOutfile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644,
+	)
+	if err != nil {
+		return err
+	}
+
+	defer fp.Close()
+	fmt.Fprintf(fp, "%s\n", json)
+
+	return nil
+}
@@ -53,7 +53,10 @@ func QueryAccountRequestHandlerFn(storeName string, cliCtx context.CLIContext) h
 
 // QueryTxsHandlerFn implements a REST handler that searches for transactions.
 // Genesis transactions are returned if the height parameter is set to zero,
-// otherwise the transactions are searched for by events.
+// otherwise the transactions are searched for by events. Besides the exact
+// events given as parameters, the events parameter takes groups of events in
+// the format of the txs query command and order_by orders the transactions
+// by height, either asc or desc.
 func QueryTxsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		err := r.ParseForm()
@@ -88,13 +91,82 @@ func QueryTxsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// the events and order_by parameters are not events themselves
+		eventsStr, orderBy := r.FormValue("events"), r.FormValue("order_by")
+		r.Form.Del("events")
+		r.Form.Del("order_by")
+
+		if orderBy == "" {
+			orderBy = utils.OrderAsc
+		} else if err := utils.ValidateOrderBy(orderBy); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		events, page, limit, err = rest.ParseHTTPArgs(r)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		searchResult, err := utils.QueryTxsByEvents(cliCtx, events, page, limit)
+		// the other parameters must match along with any of the groups of events
+		groups := [][]string{events}
+		if eventsStr != "" {
+			groups, err = utils.ParseEvents(eventsStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			for i := range groups {
+				groups[i] = append(groups[i], events...)
+			}
+		}
+
+		searchResult, err := utils.SearchTxs(cliCtx, groups, page, limit, orderBy)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, searchResult)
+	}
+}
+
+// QueryAccountHistoryRequestHandlerFn implements a REST handler that searches
+// for the transactions an account sent or received, from the newest to the
+// oldest unless order_by is asc. The other parameters are events which must
+// match as well, eg. tx.minheight and tx.maxheight.
+func QueryAccountHistoryRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = r.ParseForm()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest,
+				sdk.AppendMsgToErr("could not parse query parameters", err.Error()))
+			return
+		}
+
+		orderBy := r.FormValue("order_by")
+		r.Form.Del("order_by")
+		if orderBy == "" {
+			orderBy = utils.OrderDesc
+		} else if err := utils.ValidateOrderBy(orderBy); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		events, page, limit, err := rest.ParseHTTPArgs(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		searchResult, err := utils.QueryAccountHistory(cliCtx, addr, events, page, limit, orderBy)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -133,3 +205,38 @@ func QueryTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponseBare(w, cliCtx, output)
 	}
 }
+
+// QueryFeeTokensRequestHandlerFn - http request handler to query the tokens
+// accepted to pay fees.
+func QueryFeeTokensRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeTokens)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// QueryMinGasPricesRequestHandlerFn - http request handler to query the minimum
+// gas prices of the node.
+func QueryMinGasPricesRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		minGasPrices, err := utils.QueryMinGasPrices(cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, minGasPrices)
+	}
+}
@@ -11,12 +11,19 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	r.HandleFunc(
 		"/auth/accounts/{address}", QueryAccountRequestHandlerFn(storeName, cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/auth/fee_tokens", QueryFeeTokensRequestHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/auth/min_gas_prices", QueryMinGasPricesRequestHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // RegisterTxRoutes registers all transaction routes on the provided router.
 func RegisterTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/txs/{hash}", QueryTxRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/txs", QueryTxsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/accounts/{address}/txs", QueryAccountHistoryRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/txs", BroadcastTxRequest(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/encode", EncodeTxRequestHandlerFn(cliCtx)).Methods("POST")
 }
@@ -0,0 +1,299 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/barkisnet/barkis/client/context"
+	"github.com/barkisnet/barkis/client/keys"
+	"github.com/barkisnet/barkis/codec"
+	sdk "github.com/barkisnet/barkis/types"
+	authtypes "github.com/barkisnet/barkis/x/auth/types"
+)
+
+// BuildTxBundles bundles transactions with the chain ID of the builder and the
+// account number and sequence of their signers. The sequences of a signer are
+// consecutive over the transactions, in the given order.
+//
+// The account numbers and sequences are queried unless offline is true, in
+// which case the transactions must all have the same single signer, whose
+// account number and first sequence are the ones of the builder.
+func BuildTxBundles(txBldr authtypes.TxBuilder, cliCtx context.CLIContext,
+	txs []authtypes.StdTx, offline bool) (authtypes.TxBundles, error) {
+
+	if txBldr.ChainID() == "" {
+		return nil, errors.New("chain ID required but not specified")
+	}
+
+	type account struct{ number, sequence uint64 }
+	accounts := make(map[string]*account)
+
+	bundles := make(authtypes.TxBundles, len(txs))
+	for i, tx := range txs {
+		signers := make([]authtypes.TxBundleSigner, len(tx.GetSigners()))
+		for j, addr := range tx.GetSigners() {
+			acc, ok := accounts[addr.String()]
+			switch {
+			case ok:
+				acc.sequence++
+			case offline && len(accounts) > 0:
+				return nil, fmt.Errorf("transaction %d: offline transactions must all have the same single signer", i)
+			case offline:
+				acc = &account{txBldr.AccountNumber(), txBldr.Sequence()}
+				accounts[addr.String()] = acc
+			default:
+				num, seq, err := authtypes.NewAccountRetriever(cliCtx).GetAccountNumberSequence(addr)
+				if err != nil {
+					return nil, err
+				}
+				acc = &account{num, seq}
+				accounts[addr.String()] = acc
+			}
+			signers[j] = authtypes.NewTxBundleSigner(addr, acc.number, acc.sequence)
+		}
+
+		bundles[i] = authtypes.NewTxBundle(txBldr.ChainID(), signers, tx)
+		if err := bundles[i].ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("transaction %d: %v", i, err)
+		}
+	}
+
+	return bundles, nil
+}
+
+// SignTxBundles signs the bundles the key of the given name is a signer of,
+// with the chain ID, account number and sequence they carry, so that no node is
+// queried. It returns a copy of the bundles and the number of signed ones.
+func SignTxBundles(txBldr authtypes.TxBuilder, name string,
+	bundles authtypes.TxBundles) (authtypes.TxBundles, int, error) {
+
+	info, err := txBldr.Keybase().Get(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	addr := sdk.AccAddress(info.GetPubKey().Address())
+
+	passphrase, err := keys.GetPassphrase(name)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	signed := 0
+	signedBundles := make(authtypes.TxBundles, len(bundles))
+	for i, bundle := range bundles {
+		signedBundles[i] = bundle
+		if err := bundle.ValidateBasic(); err != nil {
+			return nil, 0, fmt.Errorf("bundle %d: %v", i, err)
+		}
+
+		index := bundle.SignerIndex(addr)
+		if index < 0 {
+			continue
+		}
+
+		sig, err := authtypes.MakeSignature(txBldr.Keybase(), name, passphrase, bundle.StdSignMsg(index))
+		if err != nil {
+			return nil, 0, err
+		}
+		signedBundles[i] = bundle.WithSignature(index, sig)
+		signed++
+	}
+
+	if signed == 0 {
+		return nil, 0, fmt.Errorf("%s: %s", errInvalidSigner, name)
+	}
+	return signedBundles, signed, nil
+}
+
+// BroadcastResult is the result of the broadcast of a transaction bundle
+type BroadcastResult struct {
+	Index    int    `json:"index" yaml:"index"` // index of the bundle in the batch
+	Sequence uint64 `json:"sequence" yaml:"sequence"`
+	TxHash   string `json:"txhash,omitempty" yaml:"txhash,omitempty"`
+	Code     uint32 `json:"code,omitempty" yaml:"code,omitempty"`
+	RawLog   string `json:"raw_log,omitempty" yaml:"raw_log,omitempty"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty"`
+	Skipped  bool   `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+}
+
+// BroadcastResults are the results of the broadcast of a batch of transaction
+// bundles, in broadcast order
+type BroadcastResults []BroadcastResult
+
+// String implements the fmt.Stringer interface
+func (rs BroadcastResults) String() string {
+	out := make([]string, len(rs))
+	for i, r := range rs {
+		switch {
+		case r.Skipped:
+			out[i] = fmt.Sprintf("%d (sequence %d): skipped", r.Index, r.Sequence)
+		case r.Error != "":
+			out[i] = fmt.Sprintf("%d (sequence %d): error: %s", r.Index, r.Sequence, r.Error)
+		default:
+			out[i] = fmt.Sprintf("%d (sequence %d): %s code %d %s", r.Index, r.Sequence, r.TxHash, r.Code, r.RawLog)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// Failed returns true if any of the bundles failed or was skipped
+func (rs BroadcastResults) Failed() bool {
+	for _, r := range rs {
+		if r.Skipped || r.Error != "" || r.Code != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// BroadcastTxBundles broadcasts fully signed bundles so that the sequences of
+// each signer strictly increase, the bundles being otherwise broadcast in the
+// order of the sequence of their first signer. The signatures of every bundle
+// are verified before any is broadcast. As the following transactions of a
+// signer would be rejected, a failed transaction stops the broadcast of the
+// bundles of its signers, which are reported as skipped, along with the ones of
+// the signers of the skipped bundles.
+func BroadcastTxBundles(cliCtx context.CLIContext, bundles authtypes.TxBundles) (BroadcastResults, error) {
+	for i, bundle := range bundles {
+		if err := bundle.VerifySignatures(); err != nil {
+			return nil, fmt.Errorf("bundle %d: %v", i, err)
+		}
+		if !bundle.IsSigned() {
+			return nil, fmt.Errorf("bundle %d: missing signatures", i)
+		}
+	}
+
+	order, err := broadcastOrder(bundles)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(BroadcastResults, len(bundles))
+	failed := make(map[string]bool)
+	for k, i := range order {
+		results[k] = BroadcastResult{Index: i, Sequence: bundles[i].Signers[0].Sequence}
+
+		skipped := false
+		for _, signer := range bundles[i].Signers {
+			skipped = skipped || failed[signer.Address.String()]
+		}
+		if skipped {
+			results[k].Skipped = true
+			failTxBundleSigners(failed, bundles[i])
+			continue
+		}
+
+		txBytes, err := cliCtx.Codec.MarshalBinaryLengthPrefixed(bundles[i].Tx)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := cliCtx.BroadcastTx(txBytes)
+		results[k].TxHash, results[k].Code, results[k].RawLog = res.TxHash, res.Code, res.RawLog
+		if err != nil {
+			results[k].Error = err.Error()
+		}
+		if err != nil || res.Code != 0 {
+			failTxBundleSigners(failed, bundles[i])
+		}
+	}
+
+	return results, nil
+}
+
+// broadcastOrder returns the order in which bundles are broadcast, so that the
+// sequences of each signer strictly increase. Among the bundles whose signers
+// are all at their next sequence, the one with the lowest sequence of its first
+// signer comes first.
+func broadcastOrder(bundles authtypes.TxBundles) ([]int, error) {
+	// the sequences of each signer left to broadcast, in increasing order
+	sequences := make(map[string][]uint64)
+	for _, bundle := range bundles {
+		for _, signer := range bundle.Signers {
+			addr := signer.Address.String()
+			sequences[addr] = append(sequences[addr], signer.Sequence)
+		}
+	}
+	for addr, seqs := range sequences {
+		sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+		for i := 1; i < len(seqs); i++ {
+			if seqs[i] == seqs[i-1] {
+				return nil, fmt.Errorf("signer %s has several bundles with sequence %d", addr, seqs[i])
+			}
+		}
+	}
+
+	order := make([]int, 0, len(bundles))
+	done := make([]bool, len(bundles))
+	for len(order) < len(bundles) {
+		next := -1
+		for i, bundle := range bundles {
+			if done[i] || !isNextTxBundle(sequences, bundle) {
+				continue
+			}
+			if next < 0 || bundle.Signers[0].Sequence < bundles[next].Signers[0].Sequence {
+				next = i
+			}
+		}
+		if next < 0 {
+			return nil, errors.New("the bundles can't be ordered so that the sequences of each signer increase")
+		}
+
+		done[next] = true
+		order = append(order, next)
+		for _, signer := range bundles[next].Signers {
+			addr := signer.Address.String()
+			sequences[addr] = sequences[addr][1:]
+		}
+	}
+	return order, nil
+}
+
+// isNextTxBundle returns true if every signer of a bundle has no lower sequence
+// left to broadcast
+func isNextTxBundle(sequences map[string][]uint64, bundle authtypes.TxBundle) bool {
+	for _, signer := range bundle.Signers {
+		if sequences[signer.Address.String()][0] != signer.Sequence {
+			return false
+		}
+	}
+	return true
+}
+
+func failTxBundleSigners(failed map[string]bool, bundle authtypes.TxBundle) {
+	for _, signer := range bundle.Signers {
+		failed[signer.Address.String()] = true
+	}
+}
+
+// ReadTxBundlesFromFile reads and decodes a batch of transaction bundles, or a
+// single one, from the given filename. Can pass "-" to read from stdin.
+func ReadTxBundlesFromFile(cdc *codec.Codec, filename string) (bundles authtypes.TxBundles, err error) {
+	var bz []byte
+
+	if filename == "-" {
+		bz, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		bz, err = ioutil.ReadFile(filename)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(bz), []byte("[")) {
+		err = cdc.UnmarshalJSON(bz, &bundles)
+		return
+	}
+
+	var bundle authtypes.TxBundle
+	if err = cdc.UnmarshalJSON(bz, &bundle); err != nil {
+		return
+	}
+	return authtypes.TxBundles{bundle}, nil
+}
@@ -0,0 +1,275 @@
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/barkisnet/barkis/client/context"
+	"github.com/barkisnet/barkis/client/flags"
+	"github.com/barkisnet/barkis/client/keys"
+	"github.com/barkisnet/barkis/codec"
+	crkeys "github.com/barkisnet/barkis/crypto/keys"
+	sdk "github.com/barkisnet/barkis/types"
+	authtypes "github.com/barkisnet/barkis/x/auth/types"
+)
+
+func makeBundleCodec() *codec.Codec {
+	var cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+	return cdc
+}
+
+func TestBuildTxBundlesOffline(t *testing.T) {
+	cdc := makeBundleCodec()
+	fee := authtypes.NewStdFee(50000, sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	txs := []authtypes.StdTx{
+		authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, fee, nil, "first"),
+		authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, fee, nil, "second"),
+	}
+	txBldr := authtypes.NewTxBuilder(GetTxEncoder(cdc), 7, 3, 0, 0, false, "test-chain", "", nil, nil)
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	// the sequences of a signer are consecutive
+	bundles, err := BuildTxBundles(txBldr, cliCtx, txs, true)
+	require.NoError(t, err)
+	require.Len(t, bundles, 2)
+	for i, bundle := range bundles {
+		require.Equal(t, "test-chain", bundle.ChainID)
+		require.Equal(t, []authtypes.TxBundleSigner{authtypes.NewTxBundleSigner(addr, 7, uint64(3+i))}, bundle.Signers)
+		require.False(t, bundle.IsSigned())
+	}
+
+	// the bundles are read back from a batch or on their own
+	bz, err := cdc.MarshalJSON(bundles)
+	require.NoError(t, err)
+	batchFile := writeToNewTempFile(t, string(bz))
+	defer os.Remove(batchFile.Name())
+	decoded, err := ReadTxBundlesFromFile(cdc, batchFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, bz, cdc.MustMarshalJSON(decoded))
+
+	bz, err = cdc.MarshalJSON(bundles[1])
+	require.NoError(t, err)
+	bundleFile := writeToNewTempFile(t, string(bz))
+	defer os.Remove(bundleFile.Name())
+	decoded, err = ReadTxBundlesFromFile(cdc, bundleFile.Name())
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	require.Equal(t, bz, cdc.MustMarshalJSON(decoded[0]))
+
+	// offline transactions must have the same single signer
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	txs = append(txs, authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(other)}, fee, nil, "third"))
+	_, err = BuildTxBundles(txBldr, cliCtx, txs, true)
+	require.Error(t, err)
+
+	// the chain ID is required
+	_, err = BuildTxBundles(txBldr.WithChainID(""), cliCtx, txs[:1], true)
+	require.Error(t, err)
+}
+
+func TestBroadcastTxBundlesRequiresSignatures(t *testing.T) {
+	cdc := makeCodec()
+	tx := authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, authtypes.NewStdFee(50000, nil), nil, "")
+	bundle := authtypes.NewTxBundle("test-chain", []authtypes.TxBundleSigner{authtypes.NewTxBundleSigner(addr, 0, 0)}, tx)
+
+	_, err := BroadcastTxBundles(context.NewCLIContext().WithCodec(cdc), authtypes.TxBundles{bundle})
+	require.Error(t, err)
+}
+
+// mockBundleClient is a node serving the accounts of the signers of the
+// bundles, and recording the memos of the transactions broadcast
+type mockBundleClient struct {
+	rpcclient.Client
+	cdc       *codec.Codec
+	accounts  map[string]authtypes.BaseAccount
+	failMemos map[string]bool
+	memos     *[]string
+}
+
+func (c mockBundleClient) ABCIQueryWithOptions(path string, data cmn.HexBytes,
+	_ rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+
+	var params authtypes.QueryAccountParams
+	if err := authtypes.ModuleCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, err
+	}
+	acc, ok := c.accounts[params.Address.String()]
+	if !ok {
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Code: 1, Log: "unknown account"}}, nil
+	}
+	bz := authtypes.ModuleCdc.MustMarshalJSON(&acc)
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func (c mockBundleClient) BroadcastTxSync(txBytes tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	var tx authtypes.StdTx
+	if err := c.cdc.UnmarshalBinaryLengthPrefixed(txBytes, &tx); err != nil {
+		return nil, err
+	}
+	*c.memos = append(*c.memos, tx.Memo)
+	res := &ctypes.ResultBroadcastTx{Hash: txBytes.Hash()}
+	if c.failMemos[tx.Memo] {
+		res.Code, res.Log = 1, "failed"
+	}
+	return res, nil
+}
+
+// signTxBundlesWithStdin signs the bundles with the key of the given name,
+// reading the passphrase from the standard input like the command does
+func signTxBundlesWithStdin(t *testing.T, txBldr authtypes.TxBuilder, name, passphrase string,
+	bundles authtypes.TxBundles) (authtypes.TxBundles, int, error) {
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	_, err = w.WriteString(passphrase + "\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	stdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = stdin }()
+	return SignTxBundles(txBldr, name, bundles)
+}
+
+func TestSignAndBroadcastTxBundles(t *testing.T) {
+	cdc := makeBundleCodec()
+	dir, err := ioutil.TempDir("", "bundle_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	viper.Set(flags.FlagHome, dir)
+	defer viper.Set(flags.FlagHome, "")
+
+	const passphrase = "12345678"
+	kb, err := keys.NewKeyBaseFromDir(dir)
+	require.NoError(t, err)
+	alice, _, err := kb.CreateMnemonic("alice", crkeys.English, passphrase, crkeys.Secp256k1)
+	require.NoError(t, err)
+	bob, _, err := kb.CreateMnemonic("bob", crkeys.English, passphrase, crkeys.Secp256k1)
+	require.NoError(t, err)
+	aliceAddr, bobAddr := sdk.AccAddress(alice.GetPubKey().Address()), sdk.AccAddress(bob.GetPubKey().Address())
+
+	var memos []string
+	node := mockBundleClient{
+		cdc: cdc,
+		accounts: map[string]authtypes.BaseAccount{
+			aliceAddr.String(): {Address: aliceAddr, AccountNumber: 1, Sequence: 4},
+			bobAddr.String():   {Address: bobAddr, AccountNumber: 2, Sequence: 9},
+		},
+		failMemos: make(map[string]bool),
+		memos:     &memos,
+	}
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithClient(node).WithTrustNode(true).
+		WithBroadcastMode(flags.BroadcastSync)
+	txBldr := authtypes.NewTxBuilder(GetTxEncoder(cdc), 0, 0, 0, 0, false, "test-chain", "", nil, nil).
+		WithKeybase(kb)
+
+	// the sequences of each signer are queried and consecutive
+	fee := authtypes.NewStdFee(50000, nil)
+	txs := []authtypes.StdTx{
+		authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(aliceAddr)}, fee, nil, "alice"),
+		authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(bobAddr)}, fee, nil, "bob"),
+		authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(aliceAddr, bobAddr)}, fee, nil, "both"),
+	}
+	bundles, err := BuildTxBundles(txBldr, cliCtx, txs, false)
+	require.NoError(t, err)
+	require.Equal(t, []authtypes.TxBundleSigner{authtypes.NewTxBundleSigner(aliceAddr, 1, 4)}, bundles[0].Signers)
+	require.Equal(t, []authtypes.TxBundleSigner{authtypes.NewTxBundleSigner(bobAddr, 2, 9)}, bundles[1].Signers)
+	require.Equal(t, []authtypes.TxBundleSigner{
+		authtypes.NewTxBundleSigner(aliceAddr, 1, 5), authtypes.NewTxBundleSigner(bobAddr, 2, 10),
+	}, bundles[2].Signers)
+
+	// each signer signs the bundles it is a signer of
+	bundles, signed, err := signTxBundlesWithStdin(t, txBldr, "alice", passphrase, bundles)
+	require.NoError(t, err)
+	require.Equal(t, 2, signed)
+	require.True(t, bundles[0].IsSigned())
+	require.False(t, bundles[1].IsSigned())
+	require.False(t, bundles[2].IsSigned())
+	_, err = BroadcastTxBundles(cliCtx, bundles)
+	require.Error(t, err)
+
+	bundles, signed, err = signTxBundlesWithStdin(t, txBldr, "bob", passphrase, bundles)
+	require.NoError(t, err)
+	require.Equal(t, 2, signed)
+	for _, bundle := range bundles {
+		require.True(t, bundle.IsSigned())
+		require.NoError(t, bundle.VerifySignatures())
+	}
+
+	// a key which signs none of the bundles is rejected
+	_, _, err = kb.CreateMnemonic("carol", crkeys.English, passphrase, crkeys.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = signTxBundlesWithStdin(t, txBldr, "carol", passphrase, bundles)
+	require.Error(t, err)
+
+	// the sequences of each signer increase whatever the order of the batch
+	reversed := authtypes.TxBundles{bundles[2], bundles[1], bundles[0]}
+	results, err := BroadcastTxBundles(cliCtx, reversed)
+	require.NoError(t, err)
+	require.False(t, results.Failed())
+	require.Equal(t, []string{"alice", "bob", "both"}, memos)
+	require.Equal(t, []int{2, 1, 0}, []int{results[0].Index, results[1].Index, results[2].Index})
+
+	// a failed transaction only stops the broadcast of its signers
+	memos = nil
+	node.failMemos["alice"] = true
+	results, err = BroadcastTxBundles(cliCtx, bundles)
+	require.NoError(t, err)
+	require.True(t, results.Failed())
+	require.Equal(t, []string{"alice", "bob"}, memos)
+	require.Equal(t, uint32(1), results[0].Code)
+	require.Equal(t, uint32(0), results[1].Code)
+	require.False(t, results[1].Skipped)
+	require.True(t, results[2].Skipped)
+
+	// the signatures are verified before any bundle is broadcast
+	memos = nil
+	tampered := bundles[2]
+	tampered.Tx.Memo = "tampered"
+	_, err = BroadcastTxBundles(cliCtx, authtypes.TxBundles{bundles[0], bundles[1], tampered})
+	require.Error(t, err)
+	require.Empty(t, memos)
+}
+
+func TestBroadcastOrder(t *testing.T) {
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	newBundle := func(signers ...authtypes.TxBundleSigner) authtypes.TxBundle {
+		return authtypes.TxBundle{ChainID: "test-chain", Signers: signers}
+	}
+
+	// the bundles of several signers are interleaved by sequence
+	bundles := authtypes.TxBundles{
+		newBundle(authtypes.NewTxBundleSigner(addr, 0, 2)),
+		newBundle(authtypes.NewTxBundleSigner(other, 1, 7), authtypes.NewTxBundleSigner(addr, 0, 1)),
+		newBundle(authtypes.NewTxBundleSigner(addr, 0, 0)),
+		newBundle(authtypes.NewTxBundleSigner(other, 1, 6)),
+	}
+	order, err := broadcastOrder(bundles)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 3, 1, 0}, order)
+
+	// a signer can't have several bundles with the same sequence
+	_, err = broadcastOrder(authtypes.TxBundles{bundles[0], bundles[0]})
+	require.Error(t, err)
+
+	// the bundles can't require both signers to go first
+	_, err = broadcastOrder(authtypes.TxBundles{
+		newBundle(authtypes.NewTxBundleSigner(addr, 0, 0), authtypes.NewTxBundleSigner(other, 1, 1)),
+		newBundle(authtypes.NewTxBundleSigner(other, 1, 0), authtypes.NewTxBundleSigner(addr, 0, 1)),
+	})
+	require.Error(t, err)
+}
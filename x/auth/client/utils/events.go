@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// EventFormat is the format of an event condition of a transaction search
+	EventFormat = "{eventType}.{eventAttribute}{operator}{value}"
+
+	// OrderAsc orders the transactions of a search from the oldest to the newest
+	OrderAsc = "asc"
+	// OrderDesc orders the transactions of a search from the newest to the oldest
+	OrderDesc = "desc"
+
+	eventsAnd   = "&"
+	eventsOr    = "|"
+	eventsRange = ".."
+)
+
+// comparison operators, the two-character ones first so that they are matched
+// before their one-character prefix
+var eventOperators = []string{">=", "<=", ">", "<", "="}
+
+var numberRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// ParseEvents parses the events of a transaction search into groups of
+// Tendermint query conditions. The conditions of a group are concatenated with
+// an 'AND' operand and a transaction matches the search if it matches any of
+// the groups.
+//
+// Groups are separated by '|' and the conditions of a group by '&'. A condition
+// takes the form of "{eventType}.{eventAttribute}{operator}{value}" where the
+// operator is one of '=', '>', '>=', '<' or '<='. The comparison operators
+// only accept numbers, and "{key}={min}..{max}" is a shorthand for an inclusive
+// range of numbers, eg:
+//
+//	message.action=send&tx.height=100..200|transfer.recipient=cosmos1...&tx.height>=100
+func ParseEvents(eventsStr string) ([][]string, error) {
+	eventsStr = strings.TrimSpace(strings.Trim(eventsStr, "'"))
+	if eventsStr == "" {
+		return nil, fmt.Errorf("must declare at least one event to search")
+	}
+
+	var groups [][]string
+	for _, groupStr := range strings.Split(eventsStr, eventsOr) {
+		var group []string
+		for _, event := range strings.Split(groupStr, eventsAnd) {
+			conditions, err := parseEvent(strings.TrimSpace(event))
+			if err != nil {
+				return nil, err
+			}
+			group = append(group, conditions...)
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// parseEvent parses a single event into Tendermint query conditions
+func parseEvent(event string) ([]string, error) {
+	for _, op := range eventOperators {
+		i := strings.Index(event, op)
+		if i < 0 {
+			continue
+		}
+
+		key, value := strings.TrimSpace(event[:i]), strings.TrimSpace(event[i+len(op):])
+		if key == "" || value == "" || strings.ContainsAny(key, "=<> '\"") || strings.ContainsAny(value, "=<>'\"") {
+			break
+		}
+
+		if op == "=" && strings.Contains(value, eventsRange) {
+			bounds := strings.Split(value, eventsRange)
+			if len(bounds) != 2 || !numberRegexp.MatchString(bounds[0]) || !numberRegexp.MatchString(bounds[1]) {
+				return nil, fmt.Errorf("invalid event %s; a range must take the form of {min}%s{max}", event, eventsRange)
+			}
+			return []string{
+				fmt.Sprintf("%s>=%s", key, bounds[0]),
+				fmt.Sprintf("%s<=%s", key, bounds[1]),
+			}, nil
+		}
+
+		if op != "=" {
+			if !numberRegexp.MatchString(value) {
+				return nil, fmt.Errorf("invalid event %s; the %s operator only accepts numbers", event, op)
+			}
+			return []string{fmt.Sprintf("%s%s%s", key, op, value)}, nil
+		}
+
+		if key == tmtypes.TxHeightKey {
+			return []string{fmt.Sprintf("%s=%s", key, value)}, nil
+		}
+		return []string{fmt.Sprintf("%s='%s'", key, value)}, nil
+	}
+
+	return nil, fmt.Errorf("invalid event; event %s should be of the format: %s", event, EventFormat)
+}
+
+// ValidateOrderBy checks the order of the transactions of a search
+func ValidateOrderBy(orderBy string) error {
+	if orderBy != OrderAsc && orderBy != OrderDesc {
+		return fmt.Errorf("invalid order %s, must be either %s or %s", orderBy, OrderAsc, OrderDesc)
+	}
+	return nil
+}
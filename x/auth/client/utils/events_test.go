@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		events    string
+		expected  [][]string
+		expectErr bool
+	}{
+		{"single event", "message.action=send", [][]string{{"message.action='send'"}}, false},
+		{"quoted events", "'message.action=send&message.sender=cosmos1abc'",
+			[][]string{{"message.action='send'", "message.sender='cosmos1abc'"}}, false},
+		{"exact height", "tx.height=10", [][]string{{"tx.height=10"}}, false},
+		{"height range", "message.action=send&tx.height=10..20",
+			[][]string{{"message.action='send'", "tx.height>=10", "tx.height<=20"}}, false},
+		{"comparisons", "tx.height>10&tx.height<=20&transfer.amount>=5&transfer.amount<1.5",
+			[][]string{{"tx.height>10", "tx.height<=20", "transfer.amount>=5", "transfer.amount<1.5"}}, false},
+		{"or groups", "transfer.recipient=cosmos1abc&tx.height>=100 | transfer.sender=cosmos1abc",
+			[][]string{{"transfer.recipient='cosmos1abc'", "tx.height>=100"}, {"transfer.sender='cosmos1abc'"}}, false},
+		{"empty", "", nil, true},
+		{"empty group", "message.action=send|", nil, true},
+		{"missing operator", "message.action", nil, true},
+		{"missing value", "message.action=", nil, true},
+		{"missing key", "=send", nil, true},
+		{"two operators", "message.action=send=receive", nil, true},
+		{"reversed operator", "tx.height=>10", nil, true},
+		{"comparison with a string", "message.sender>cosmos1abc", nil, true},
+		{"negative number", "tx.height>-1", nil, true},
+		{"quote in value", "message.action=se'nd", nil, true},
+		{"invalid range", "tx.height=10..", nil, true},
+		{"range of strings", "tx.height=a..b", nil, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := ParseEvents(tt.events)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, groups)
+		})
+	}
+}
+
+func TestValidateOrderBy(t *testing.T) {
+	require.NoError(t, ValidateOrderBy(OrderAsc))
+	require.NoError(t, ValidateOrderBy(OrderDesc))
+	require.Error(t, ValidateOrderBy(""))
+	require.Error(t, ValidateOrderBy("newest"))
+}
@@ -3,26 +3,57 @@ package utils
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/barkisnet/barkis/client/context"
 	"github.com/barkisnet/barkis/codec"
 	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/x/auth/types"
+	stakingtypes "github.com/barkisnet/barkis/x/staking/types"
 )
 
+// maxSearchPerPage is the maximum number of transactions Tendermint returns
+// per page of a search
+const maxSearchPerPage = 100
+
+// maxMergedSearchTxs is the maximum number of transactions fetched from each
+// group of a search to merge them
+const maxMergedSearchTxs = 10000
+
 // QueryTxsByEvents performs a search for transactions for a given set of events
 // via the Tendermint RPC. An event takes the form of:
 // "{eventAttribute}.{attributeKey} = '{attributeValue}'". Each event is
 // concatenated with an 'AND' operand. It returns a slice of Info object
 // containing txs and metadata. An error is returned if the query fails.
 func QueryTxsByEvents(cliCtx context.CLIContext, events []string, page, limit int) (*sdk.SearchTxsResult, error) {
-	if len(events) == 0 {
+	return SearchTxs(cliCtx, [][]string{events}, page, limit, OrderAsc)
+}
+
+// SearchTxs performs a search for transactions matching any of the given groups
+// of events via the Tendermint RPC, see ParseEvents. The events of a group are
+// concatenated with an 'AND' operand. The transactions are ordered by height
+// and index in the block, from the oldest to the newest or the other way round.
+//
+// Tendermint has no 'OR' operand, so each group is searched on its own and the
+// results are merged, which fetches from each group the transactions up to the
+// requested page. Such a search can't page past maxMergedSearchTxs
+// transactions, and its total count only accounts for the duplicates among the
+// fetched transactions, so that it is only exact once the pages reach the end
+// of the results.
+func SearchTxs(cliCtx context.CLIContext, groups [][]string, page, limit int, orderBy string) (*sdk.SearchTxsResult, error) {
+	if len(groups) == 0 {
 		return nil, errors.New("must declare at least one event to search")
 	}
+	for _, events := range groups {
+		if len(events) == 0 {
+			return nil, errors.New("must declare at least one event to search")
+		}
+	}
 
 	if page <= 0 {
 		return nil, errors.New("page must greater than 0")
@@ -32,8 +63,9 @@ func QueryTxsByEvents(cliCtx context.CLIContext, events []string, page, limit in
 		return nil, errors.New("limit must greater than 0")
 	}
 
-	// XXX: implement ANY
-	query := strings.Join(events, " AND ")
+	if err := ValidateOrderBy(orderBy); err != nil {
+		return nil, err
+	}
 
 	node, err := cliCtx.GetNode()
 	if err != nil {
@@ -42,13 +74,78 @@ func QueryTxsByEvents(cliCtx context.CLIContext, events []string, page, limit in
 
 	prove := !cliCtx.TrustNode
 
-	resTxs, err := node.TxSearch(query, prove, page, limit)
-	if err != nil {
-		return nil, err
+	var (
+		totalCount int
+		resTxs     []*ctypes.ResultTx
+	)
+	switch {
+	case len(groups) == 1 && orderBy == OrderAsc:
+		resTxSearch, err := node.TxSearch(strings.Join(groups[0], " AND "), prove, page, limit)
+		if err != nil {
+			return nil, err
+		}
+		totalCount, resTxs = resTxSearch.TotalCount, resTxSearch.Txs
+
+	case len(groups) == 1:
+		// fetch the matching page from the end of the ascending results
+		query := strings.Join(groups[0], " AND ")
+		totalCount, err = searchTxsCount(node, query)
+		if err != nil {
+			return nil, err
+		}
+		start, end := descPageBounds(totalCount, page, limit)
+		resTxs, err = searchTxsRange(node, query, prove, start, end)
+		if err != nil {
+			return nil, err
+		}
+		reverseTxResults(resTxs)
+
+	default:
+		// only the transactions up to the requested page are needed from each
+		// group, from the end of the ascending results for the descending order
+		depth := page * limit
+		if depth > maxMergedSearchTxs {
+			return nil, fmt.Errorf("cannot page past the first %d transactions of the search, please narrow it down", maxMergedSearchTxs)
+		}
+		results := make([][]*ctypes.ResultTx, len(groups))
+		for i, events := range groups {
+			query := strings.Join(events, " AND ")
+			count, err := searchTxsCount(node, query)
+			if err != nil {
+				return nil, err
+			}
+			totalCount += count
+
+			start, end := 0, count
+			if end > depth {
+				end = depth
+			}
+			if orderBy == OrderDesc {
+				start, end = descPageBounds(count, 1, depth)
+			}
+			results[i], err = searchTxsRange(node, query, prove, start, end)
+			if err != nil {
+				return nil, err
+			}
+			if orderBy == OrderDesc {
+				reverseTxResults(results[i])
+			}
+		}
+
+		merged, duplicates := mergeTxResults(results, orderBy, depth)
+		totalCount -= duplicates
+		start, end := (page-1)*limit, depth
+		if start > len(merged) {
+			start = len(merged)
+		}
+		if end > len(merged) {
+			end = len(merged)
+		}
+		resTxs = merged[start:end]
 	}
 
 	if prove {
-		for _, tx := range resTxs.Txs {
+		for _, tx := range resTxs {
 			err := ValidateTxResult(cliCtx, tx)
 			if err != nil {
 				return nil, err
@@ -56,21 +153,178 @@ func QueryTxsByEvents(cliCtx context.CLIContext, events []string, page, limit in
 		}
 	}
 
-	resBlocks, err := getBlocksForTxResults(cliCtx, resTxs.Txs)
+	resBlocks, err := getBlocksForTxResults(cliCtx, resTxs)
 	if err != nil {
 		return nil, err
 	}
 
-	txs, err := formatTxResults(cliCtx.Codec, resTxs.Txs, resBlocks)
+	txs, err := formatTxResults(cliCtx.Codec, resTxs, resBlocks)
 	if err != nil {
 		return nil, err
 	}
 
-	result := sdk.NewSearchTxsResult(resTxs.TotalCount, len(txs), page, limit, txs)
+	result := sdk.NewSearchTxsResult(totalCount, len(txs), page, limit, txs)
 
 	return &result, nil
 }
 
+// accountHistoryEvents are the events naming the accounts taking part in a
+// transaction: the signers of its messages, the recipients of its transfers
+// and the new delegators of its delegation transfers
+var accountHistoryEvents = []string{
+	fmt.Sprintf("%s.%s", sdk.EventTypeMessage, sdk.AttributeKeySender),
+	"transfer.recipient",
+	"transfer_delegation.new_delegator",
+}
+
+// QueryAccountHistory performs a search for the transactions an account took
+// part in, sent or received, see SearchTxs. The events are concatenated with
+// an 'AND' operand to the search, eg. to restrict it to a range of heights.
+func QueryAccountHistory(cliCtx context.CLIContext, addr sdk.AccAddress, events []string,
+	page, limit int, orderBy string) (*sdk.SearchTxsResult, error) {
+
+	groups := make([][]string, len(accountHistoryEvents))
+	for i, key := range accountHistoryEvents {
+		groups[i] = append([]string{fmt.Sprintf("%s='%s'", key, addr)}, events...)
+	}
+	return SearchTxs(cliCtx, groups, page, limit, orderBy)
+}
+
+// searchTxsCount returns the number of transactions matching a query
+func searchTxsCount(node rpcclient.Client, query string) (int, error) {
+	resTxSearch, err := node.TxSearch(query, false, 1, 1)
+	if err != nil {
+		return 0, err
+	}
+	return resTxSearch.TotalCount, nil
+}
+
+// searchTxsRange returns the transactions matching a query from the start
+// index (inclusive) to the end index (exclusive) of the ascending results
+func searchTxsRange(node rpcclient.Client, query string, prove bool, start, end int) ([]*ctypes.ResultTx, error) {
+	resTxs := make([]*ctypes.ResultTx, 0, end-start)
+	for page := start/maxSearchPerPage + 1; (page-1)*maxSearchPerPage < end; page++ {
+		resTxSearch, err := node.TxSearch(query, prove, page, maxSearchPerPage)
+		if err != nil {
+			return nil, err
+		}
+		for i, tx := range resTxSearch.Txs {
+			if index := (page-1)*maxSearchPerPage + i; index >= start && index < end {
+				resTxs = append(resTxs, tx)
+			}
+		}
+	}
+	return resTxs, nil
+}
+
+// descPageBounds returns the range of the ascending results of a search which
+// holds the given page of the descending results
+func descPageBounds(totalCount, page, limit int) (start, end int) {
+	end = totalCount - (page-1)*limit
+	if end < 0 {
+		end = 0
+	}
+	start = end - limit
+	if start < 0 {
+		start = 0
+	}
+	return start, end
+}
+
+// mergeTxResults merges the results of several searches, each one ordered by
+// height and index in the block, up to the given number of transactions. A
+// transaction found by several searches is merged once, the number of such
+// duplicates is returned along with the merged transactions.
+func mergeTxResults(results [][]*ctypes.ResultTx, orderBy string, max int) (merged []*ctypes.ResultTx, duplicates int) {
+	before := func(a, b *ctypes.ResultTx) bool {
+		if a.Height == b.Height {
+			return a.Index < b.Index
+		}
+		return a.Height < b.Height
+	}
+	if orderBy == OrderDesc {
+		before = func(a, b *ctypes.ResultTx) bool {
+			if a.Height == b.Height {
+				return a.Index > b.Index
+			}
+			return a.Height > b.Height
+		}
+	}
+
+	heads := make([]int, len(results))
+	for len(merged) < max {
+		var next *ctypes.ResultTx
+		for i, resTxs := range results {
+			if heads[i] < len(resTxs) && (next == nil || before(resTxs[heads[i]], next)) {
+				next = resTxs[heads[i]]
+			}
+		}
+		if next == nil {
+			break
+		}
+
+		merged = append(merged, next)
+		found := 0
+		for i, resTxs := range results {
+			if heads[i] < len(resTxs) && resTxs[heads[i]].Height == next.Height && resTxs[heads[i]].Index == next.Index {
+				found++
+				heads[i]++
+			}
+		}
+		duplicates += found - 1
+	}
+	return merged, duplicates
+}
+
+func reverseTxResults(resTxs []*ctypes.ResultTx) {
+	for i, j := 0, len(resTxs)-1; i < j; i, j = i+1, j-1 {
+		resTxs[i], resTxs[j] = resTxs[j], resTxs[i]
+	}
+}
+
+// QueryMinGasPrices queries the minimum gas prices of the node, under which it
+// does not accept transactions in its mempool.
+func QueryMinGasPrices(cliCtx context.CLIContext) (sdk.DecCoins, error) {
+	res, _, err := cliCtx.QueryWithData("/app/min_gas_prices", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ParseDecCoins(string(res))
+}
+
+// QueryFeeTokens queries the tokens accepted to pay fees along with their
+// exchange rate to the bond denom.
+func QueryFeeTokens(cliCtx context.CLIContext) (types.FeeTokens, error) {
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeTokens)
+	res, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var feeTokens types.FeeTokens
+	if err := cliCtx.Codec.UnmarshalJSON(res, &feeTokens); err != nil {
+		return nil, err
+	}
+	return feeTokens, nil
+}
+
+// QueryBondDenom queries the bond denom of the staking parameters, in which
+// the fee tokens are converted.
+func QueryBondDenom(cliCtx context.CLIContext) (string, error) {
+	route := fmt.Sprintf("custom/%s/%s", stakingtypes.QuerierRoute, stakingtypes.QueryParameters)
+	res, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var params stakingtypes.Params
+	if err := cliCtx.Codec.UnmarshalJSON(res, &params); err != nil {
+		return "", err
+	}
+	return params.BondDenom, nil
+}
+
 // QueryTx queries for a single transaction by a hash string in hex format. An
 // error is returned if the transaction does not exist or cannot be queried.
 func QueryTx(cliCtx context.CLIContext, hashHexStr string) (sdk.TxResponse, error) {
@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/barkisnet/barkis/client/context"
+	sdk "github.com/barkisnet/barkis/types"
+	authtypes "github.com/barkisnet/barkis/x/auth/types"
+)
+
+func TestDescPageBounds(t *testing.T) {
+	tests := []struct {
+		totalCount, page, limit int
+		start, end              int
+	}{
+		{0, 1, 10, 0, 0},
+		{25, 1, 10, 15, 25},
+		{25, 2, 10, 5, 15},
+		{25, 3, 10, 0, 5},
+		{25, 4, 10, 0, 0},
+		{5, 1, 10, 0, 5},
+	}
+	for _, tt := range tests {
+		start, end := descPageBounds(tt.totalCount, tt.page, tt.limit)
+		require.Equal(t, tt.start, start, "%+v", tt)
+		require.Equal(t, tt.end, end, "%+v", tt)
+	}
+}
+
+func newResTx(hash byte, height int64, index uint32) *ctypes.ResultTx {
+	return &ctypes.ResultTx{Hash: []byte{hash}, Height: height, Index: index}
+}
+
+func TestMergeTxResults(t *testing.T) {
+	a, b, c, d := newResTx(1, 10, 0), newResTx(2, 10, 1), newResTx(3, 5, 0), newResTx(4, 20, 0)
+	a2 := newResTx(1, 10, 0)
+
+	merged, duplicates := mergeTxResults([][]*ctypes.ResultTx{{a, d}, {c, a2, b}}, OrderAsc, 10)
+	require.Equal(t, []*ctypes.ResultTx{c, a, b, d}, merged)
+	require.Equal(t, 1, duplicates)
+
+	merged, duplicates = mergeTxResults([][]*ctypes.ResultTx{{d, a}, {b, a2, c}}, OrderDesc, 10)
+	require.Equal(t, []*ctypes.ResultTx{d, b, a, c}, merged)
+	require.Equal(t, 1, duplicates)
+
+	// the merge stops at the given number of transactions
+	merged, duplicates = mergeTxResults([][]*ctypes.ResultTx{{a, d}, {c, a2, b}}, OrderAsc, 2)
+	require.Equal(t, []*ctypes.ResultTx{c, a}, merged)
+	require.Equal(t, 1, duplicates)
+
+	merged, duplicates = mergeTxResults(nil, OrderAsc, 10)
+	require.Empty(t, merged)
+	require.Zero(t, duplicates)
+}
+
+// mockSearchClient is a node serving the searches of the transactions from
+// the results of each query, ordered by height and index
+type mockSearchClient struct {
+	rpcclient.Client
+	results map[string][]*ctypes.ResultTx
+}
+
+func (c mockSearchClient) TxSearch(query string, _ bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+	resTxs := c.results[query]
+	start, end := (page-1)*perPage, page*perPage
+	if start > len(resTxs) {
+		start = len(resTxs)
+	}
+	if end > len(resTxs) {
+		end = len(resTxs)
+	}
+	return &ctypes.ResultTxSearch{Txs: resTxs[start:end], TotalCount: len(resTxs)}, nil
+}
+
+func (c mockSearchClient) Block(height *int64) (*ctypes.ResultBlock, error) {
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: *height, Time: time.Unix(*height, 0)}}
+	return &ctypes.ResultBlock{Block: block}, nil
+}
+
+func TestQueryAccountHistory(t *testing.T) {
+	cdc := makeBundleCodec()
+	newAccountTx := func(memo string, height int64, index uint32) *ctypes.ResultTx {
+		tx := authtypes.NewStdTx(nil, authtypes.NewStdFee(50000, nil), nil, memo)
+		return &ctypes.ResultTx{
+			Hash:   []byte(memo),
+			Height: height,
+			Index:  index,
+			Tx:     cdc.MustMarshalBinaryLengthPrefixed(tx),
+		}
+	}
+	// sent, received, sent to itself, delegation received and received twice
+	sent, received := newAccountTx("sent", 3, 1), newAccountTx("received", 5, 0)
+	self, delegation := newAccountTx("self", 5, 2), newAccountTx("delegation", 8, 0)
+	twice := newAccountTx("twice", 8, 1)
+
+	queries := make([]string, len(accountHistoryEvents))
+	for i, key := range accountHistoryEvents {
+		queries[i] = fmt.Sprintf("%s='%s'", key, addr)
+	}
+	node := mockSearchClient{results: map[string][]*ctypes.ResultTx{
+		queries[0]: {sent, self},
+		queries[1]: {received, self, twice},
+		queries[2]: {delegation, twice},
+	}}
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithClient(node).WithTrustNode(true)
+
+	memos := func(res *sdk.SearchTxsResult) (memos []string) {
+		for _, txResponse := range res.Txs {
+			memos = append(memos, txResponse.Tx.(authtypes.StdTx).Memo)
+		}
+		return memos
+	}
+
+	// the transactions found by several groups are listed once
+	res, err := QueryAccountHistory(cliCtx, addr, nil, 1, 10, OrderAsc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"sent", "received", "self", "delegation", "twice"}, memos(res))
+	require.Equal(t, 5, res.TotalCount)
+
+	res, err = QueryAccountHistory(cliCtx, addr, nil, 1, 10, OrderDesc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"twice", "delegation", "self", "received", "sent"}, memos(res))
+	require.Equal(t, 5, res.TotalCount)
+
+	// the pages follow the order
+	res, err = QueryAccountHistory(cliCtx, addr, nil, 2, 2, OrderAsc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"self", "delegation"}, memos(res))
+
+	res, err = QueryAccountHistory(cliCtx, addr, nil, 2, 2, OrderDesc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"self", "received"}, memos(res))
+
+	res, err = QueryAccountHistory(cliCtx, addr, nil, 3, 2, OrderDesc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"sent"}, memos(res))
+	require.Equal(t, 5, res.TotalCount)
+
+	res, err = QueryAccountHistory(cliCtx, addr, nil, 4, 2, OrderDesc)
+	require.NoError(t, err)
+	require.Empty(t, res.Txs)
+
+	// the pages past the fetch cap are rejected
+	_, err = QueryAccountHistory(cliCtx, addr, nil, 2, maxMergedSearchTxs, OrderAsc)
+	require.Error(t, err)
+}
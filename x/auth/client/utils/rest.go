@@ -30,6 +30,15 @@ func WriteGenerateStdTxResponse(w http.ResponseWriter, cliCtx context.CLIContext
 		br.Simulate, br.ChainID, br.Memo, br.Fees, br.GasPrices,
 	)
 
+	if br.FeePayer != "" {
+		feePayer, err := sdk.AccAddressFromBech32(br.FeePayer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		txBldr = txBldr.WithFeePayer(feePayer)
+	}
+
 	if br.Simulate || simAndExec {
 		if gasAdj < 0 {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, errInvalidGasAdjustment.Error())
@@ -66,6 +66,15 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
 	}
 
+	if txBldr.AutoFees() {
+		txBldr, err = EnrichWithFees(txBldr, cliCtx)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "fees = %s\n", txBldr.Fees())
+	}
+
 	if cliCtx.Simulate {
 		return nil
 	}
@@ -135,6 +144,27 @@ func EnrichWithGas(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs [
 	return txBldr.WithGas(adjusted), nil
 }
 
+// EnrichWithFees computes the fees of the transaction from the minimum gas
+// prices of the node and sets the transaction's respective value accordingly.
+func EnrichWithFees(txBldr authtypes.TxBuilder, cliCtx context.CLIContext) (authtypes.TxBuilder, error) {
+	minGasPrices, err := QueryMinGasPrices(cliCtx)
+	if err != nil {
+		return txBldr, err
+	}
+
+	feeTokens, err := QueryFeeTokens(cliCtx)
+	if err != nil {
+		return txBldr, err
+	}
+
+	bondDenom, err := QueryBondDenom(cliCtx)
+	if err != nil {
+		return txBldr, err
+	}
+
+	return txBldr.WithMinGasPricesFees(minGasPrices, feeTokens, bondDenom)
+}
+
 // CalculateGas simulates the execution of a transaction and returns
 // both the estimate obtained by the query and the adjusted amount.
 func CalculateGas(
@@ -46,6 +46,7 @@ type VestingAccount interface {
 	// coins amount.
 	TrackDelegation(blockTime time.Time, amount sdk.Coins)
 	TrackUndelegation(amount sdk.Coins)
+	TrackDelegationTransfer(amount sdk.Coins)
 
 	GetVestedCoins(blockTime time.Time) sdk.Coins
 	GetVestingCoins(blockTime time.Time) sdk.Coins
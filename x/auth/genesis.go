@@ -10,10 +10,12 @@ import (
 // a genesis port script to the new fee collector account
 func InitGenesis(ctx sdk.Context, ak AccountKeeper, data GenesisState) {
 	ak.SetParams(ctx, data.Params)
+	ak.SetFeeTokens(ctx, data.FeeTokens)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func ExportGenesis(ctx sdk.Context, ak AccountKeeper) GenesisState {
 	params := ak.GetParams(ctx)
-	return NewGenesisState(params)
+	feeTokens := ak.GetFeeTokens(ctx)
+	return NewGenesisState(params, feeTokens)
 }
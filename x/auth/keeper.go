@@ -183,6 +183,18 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
+// GetFeeTokens returns the whitelist of tokens accepted to pay fees, which is
+// empty if it has never been set.
+func (ak AccountKeeper) GetFeeTokens(ctx sdk.Context) (feeTokens types.FeeTokens) {
+	ak.paramSubspace.GetIfExists(ctx, types.KeyFeeTokens, &feeTokens)
+	return
+}
+
+// SetFeeTokens sets the whitelist of tokens accepted to pay fees.
+func (ak AccountKeeper) SetFeeTokens(ctx sdk.Context, feeTokens types.FeeTokens) {
+	ak.paramSubspace.Set(ctx, types.KeyFeeTokens, feeTokens)
+}
+
 // -----------------------------------------------------------------------------
 // Misc.
 
@@ -88,3 +88,22 @@ func TestGetParams(t *testing.T) {
 	newParams := input.ak.GetParams(input.ctx)
 	require.Equal(t, params, newParams)
 }
+
+func TestGetSetFeeTokens(t *testing.T) {
+	input := setupTestInput()
+
+	// fee tokens are empty until they are set
+	require.Empty(t, input.ak.GetFeeTokens(input.ctx))
+
+	feeTokens := FeeTokens{NewFeeToken("btc", sdk.NewDec(2)), NewFeeToken("eth", sdk.NewDecWithPrec(5, 1))}
+	input.ak.SetFeeTokens(input.ctx, feeTokens)
+	require.Equal(t, feeTokens, input.ak.GetFeeTokens(input.ctx))
+
+	// require the fee tokens set by a parameter change to be validated
+	require.Error(t, input.ak.paramSubspace.Update(input.ctx, KeyFeeTokens, []byte(`[{"denom":"btc","rate":"0.000000000000000000"}]`)))
+	require.Error(t, input.ak.paramSubspace.Update(input.ctx, KeyFeeTokens, []byte(`[{"denom":"ubarkis","rate":"1.000000000000000000"}]`)))
+	require.Equal(t, feeTokens, input.ak.GetFeeTokens(input.ctx))
+
+	require.NoError(t, input.ak.paramSubspace.Update(input.ctx, KeyFeeTokens, []byte(`[{"denom":"btc","rate":"3.000000000000000000"}]`)))
+	require.Equal(t, FeeTokens{NewFeeToken("btc", sdk.NewDec(3))}, input.ak.GetFeeTokens(input.ctx))
+}
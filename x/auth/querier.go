@@ -16,6 +16,8 @@ func NewQuerier(keeper AccountKeeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryAccount:
 			return queryAccount(ctx, req, keeper)
+		case types.QueryFeeTokens:
+			return queryFeeTokens(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -40,3 +42,18 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper)
 
 	return bz, nil
 }
+
+func queryFeeTokens(ctx sdk.Context, keeper AccountKeeper) ([]byte, sdk.Error) {
+	// no fee token is accepted until they are supported
+	feeTokens := types.FeeTokens{}
+	if sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.FeeTokenUpgrade) {
+		feeTokens = keeper.GetFeeTokens(ctx)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, feeTokens)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
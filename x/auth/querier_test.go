@@ -8,6 +8,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/x/auth/types"
 )
 
@@ -42,3 +43,28 @@ func Test_queryAccount(t *testing.T) {
 	err2 := input.cdc.UnmarshalJSON(res, &account)
 	require.Nil(t, err2)
 }
+
+func Test_queryFeeTokens(t *testing.T) {
+	input := setupTestInput()
+	feeTokens := types.FeeTokens{types.NewFeeToken("usdt", sdk.NewDecWithPrec(3, 1))}
+	input.ak.SetFeeTokens(input.ctx, feeTokens)
+
+	// no fee token is accepted before the upgrade
+	res, err := queryFeeTokens(input.ctx, input.ak)
+	require.Nil(t, err)
+	var queried types.FeeTokens
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &queried))
+	require.Empty(t, queried)
+
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.FeeTokenUpgrade, 1)
+	sdk.GlobalUpgradeMgr.SetBlockHeight(1)
+	defer func() {
+		delete(sdk.GlobalUpgradeMgr.Config.UpgradeHeight, sdk.FeeTokenUpgrade)
+		sdk.GlobalUpgradeMgr.SetBlockHeight(0)
+	}()
+
+	res, err = queryFeeTokens(input.ctx, input.ak)
+	require.Nil(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &queried))
+	require.Equal(t, feeTokens, queried)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -329,6 +330,13 @@ func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
 	}
 }
 
+// TrackDelegationTransfer tracks a delegation transferred to the account. The
+// transferred coins were never part of the account's vesting coins, so they
+// are tracked as delegated free coins, leaving the base coins untouched.
+func (bva *BaseVestingAccount) TrackDelegationTransfer(amount sdk.Coins) {
+	bva.DelegatedFree = bva.DelegatedFree.Add(amount)
+}
+
 // GetOriginalVesting returns a vesting account's original vesting amount
 func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
 	return bva.OriginalVesting
@@ -535,3 +543,220 @@ func (dva *DelayedVestingAccount) GetStartTime() int64 {
 func (dva *DelayedVestingAccount) GetEndTime() int64 {
 	return dva.EndTime
 }
+
+//-----------------------------------------------------------------------------
+// Periodic Vesting Account
+
+var _ exported.VestingAccount = (*PeriodicVestingAccount)(nil)
+
+// Period defines a length of time and amount of coins that will vest
+type Period struct {
+	Length int64     `json:"length" yaml:"length"` // length of the period, in seconds
+	Amount sdk.Coins `json:"amount" yaml:"amount"` // amount of coins vesting during this period
+}
+
+// String implements fmt.Stringer
+func (p Period) String() string {
+	return fmt.Sprintf(`Length: %d
+  Amount: %s`, p.Length, p.Amount)
+}
+
+// Periods stores all vesting periods passed as part of a PeriodicVestingAccount
+type Periods []Period
+
+// String implements fmt.Stringer
+func (vp Periods) String() string {
+	periodsListString := make([]string, len(vp))
+	for i, period := range vp {
+		periodsListString[i] = period.String()
+	}
+
+	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
+  %s`, strings.Join(periodsListString, ", ")))
+}
+
+// TotalLength returns the summed length of all the periods
+func (vp Periods) TotalLength() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, period := range vp {
+		total = total.Add(sdk.NewInt(period.Length))
+	}
+	return total
+}
+
+// EndTime returns the time when the periods starting at the given time end,
+// or an error if it overflows
+func (vp Periods) EndTime(startTime int64) (int64, error) {
+	endTime := sdk.NewInt(startTime).Add(vp.TotalLength())
+	if !endTime.IsInt64() {
+		return 0, fmt.Errorf("vesting periods end time overflows, start time %d, total length %s", startTime, vp.TotalLength())
+	}
+	return endTime.Int64(), nil
+}
+
+// TotalAmount returns the summed amount of all the periods
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount)
+	}
+	return total
+}
+
+// Validate returns an error if any of the periods has a non-positive length
+// or an invalid amount.
+func (vp Periods) Validate() error {
+	if len(vp) == 0 {
+		return errors.New("vesting periods cannot be empty")
+	}
+
+	for i, period := range vp {
+		if period.Length <= 0 {
+			return fmt.Errorf("vesting period #%d has a non-positive length %d", i, period.Length)
+		}
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return fmt.Errorf("vesting period #%d has an invalid amount %s", i, period.Amount)
+		}
+	}
+
+	if !vp.TotalLength().IsInt64() {
+		return fmt.Errorf("vesting periods total length %s overflows", vp.TotalLength())
+	}
+	return nil
+}
+
+// PeriodicVestingAccount implements the VestingAccount interface. It
+// periodically vests by unlocking coins during each specified period.
+type PeriodicVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime      int64   `json:"start_time"`      // when the coins start to vest
+	VestingPeriods Periods `json:"vesting_periods"` // unlocking schedule relative to the start time
+}
+
+// NewPeriodicVestingAccountRaw creates a new PeriodicVestingAccount object from BaseVestingAccount
+func NewPeriodicVestingAccountRaw(bva *BaseVestingAccount,
+	startTime int64, periods Periods) *PeriodicVestingAccount {
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount. The original
+// vesting amount is the sum of all the periods, and the end time is the start
+// time plus the length of all the periods, which must not overflow.
+func NewPeriodicVestingAccount(
+	baseAcc *BaseAccount, StartTime int64, periods Periods,
+) *PeriodicVestingAccount {
+
+	endTime, err := periods.EndTime(StartTime)
+	if err != nil {
+		panic(err)
+	}
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: periods.TotalAmount(),
+		EndTime:         endTime,
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          StartTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	var pubkey string
+
+	if pva.PubKey != nil {
+		pubkey = sdk.MustBech32ifyAccPub(pva.PubKey)
+	}
+
+	return fmt.Sprintf(`Periodic Vesting Account:
+  Address:          %s
+  Pubkey:           %s
+  Coins:            %s
+  AccountNumber:    %d
+  Sequence:         %d
+  OriginalVesting:  %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s
+  StartTime:        %d
+  EndTime:          %d
+  %s`,
+		pva.Address, pubkey, pva.Coins, pva.AccountNumber, pva.Sequence,
+		pva.OriginalVesting, pva.DelegatedFree, pva.DelegatedVesting,
+		pva.StartTime, pva.EndTime, pva.VestingPeriods,
+	)
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	// track the start time of the next period
+	currentPeriodStartTime := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		x := blockTime.Unix() - currentPeriodStartTime
+		if x < period.Length {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount)
+
+		// update the start time of the next period
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// periodic vesting account.
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return pva.spendableCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	pva.trackDelegation(pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a periodic vesting
+// account.
+func (pva *PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// GetEndTime returns the time when vesting ends for a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetEndTime() int64 {
+	return pva.EndTime
+}
+
+// GetVestingPeriods returns the vesting schedule of a periodic vesting account.
+func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
+	return pva.VestingPeriods
+}
@@ -11,3 +11,13 @@ type SupplyKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// FeeGrantKeeper defines the expected fee grant Keeper (noalias)
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error
+}
+
+// StakingKeeper defines the expected staking Keeper (noalias)
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
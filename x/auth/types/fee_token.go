@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// FeeToken defines a token which is accepted to pay transaction fees. Rate is
+// the amount of bond denom a single unit of the token is worth.
+type FeeToken struct {
+	Denom string  `json:"denom" yaml:"denom"`
+	Rate  sdk.Dec `json:"rate" yaml:"rate"`
+}
+
+// NewFeeToken creates a new FeeToken object
+func NewFeeToken(denom string, rate sdk.Dec) FeeToken {
+	return FeeToken{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// String implements the stringer interface.
+func (ft FeeToken) String() string {
+	return fmt.Sprintf("%s: %s", ft.Denom, ft.Rate)
+}
+
+// FeeTokens defines the whitelist of tokens accepted to pay transaction fees
+type FeeTokens []FeeToken
+
+// String implements the stringer interface.
+func (fts FeeTokens) String() string {
+	if len(fts) == 0 {
+		return "[]"
+	}
+
+	strs := make([]string, len(fts))
+	for i, ft := range fts {
+		strs[i] = ft.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Validate checks that all fee tokens have a valid denom and a positive rate,
+// and that no denom is listed twice or is the bond denom itself.
+func (fts FeeTokens) Validate() error {
+	seen := make(map[string]bool)
+	for _, ft := range fts {
+		if !(sdk.Coins{sdk.Coin{Denom: ft.Denom, Amount: sdk.OneInt()}}).IsValid() {
+			return fmt.Errorf("invalid fee token denom: %q", ft.Denom)
+		}
+		if ft.Denom == sdk.DefaultBondDenom {
+			return fmt.Errorf("bond denom %s cannot be a fee token", ft.Denom)
+		}
+		if seen[ft.Denom] {
+			return fmt.Errorf("duplicate fee token %s", ft.Denom)
+		}
+		if ft.Rate.IsNil() || !ft.Rate.IsPositive() {
+			return fmt.Errorf("fee token %s rate must be positive: %s", ft.Denom, ft.Rate)
+		}
+		seen[ft.Denom] = true
+	}
+	return nil
+}
+
+// Get returns the fee token with the given denom and whether it is whitelisted.
+func (fts FeeTokens) Get(denom string) (FeeToken, bool) {
+	for _, ft := range fts {
+		if ft.Denom == denom {
+			return ft, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// IsAccepted returns true if all coins of fees are either in the given bond
+// denom or in a whitelisted fee token.
+func (fts FeeTokens) IsAccepted(fees sdk.Coins, bondDenom string) bool {
+	for _, fee := range fees {
+		if fee.Denom == bondDenom {
+			continue
+		}
+		if _, ok := fts.Get(fee.Denom); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ConvertToBondDenom replaces the coins of fees which are whitelisted fee
+// tokens by their equivalent in the given bond denom, truncated. Other coins
+// are kept as is.
+func (fts FeeTokens) ConvertToBondDenom(fees sdk.Coins, bondDenom string) sdk.Coins {
+	var converted sdk.Coins
+	for _, fee := range fees {
+		ft, ok := fts.Get(fee.Denom)
+		if fee.Denom == bondDenom || !ok || ft.Rate.IsNil() || !ft.Rate.IsPositive() {
+			converted = converted.Add(sdk.Coins{fee})
+			continue
+		}
+
+		amount := ft.Rate.MulInt(fee.Amount).TruncateInt()
+		converted = converted.Add(sdk.Coins{sdk.NewCoin(bondDenom, amount)})
+	}
+	return converted
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+func TestFeeTokensValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		feeTokens FeeTokens
+		expectErr bool
+	}{
+		{"empty", FeeTokens{}, false},
+		{"valid", FeeTokens{NewFeeToken("btc", sdk.NewDec(2)), NewFeeToken("eth", sdk.NewDecWithPrec(5, 1))}, false},
+		{"invalid denom", FeeTokens{NewFeeToken("B", sdk.NewDec(2))}, true},
+		{"bond denom", FeeTokens{NewFeeToken(sdk.DefaultBondDenom, sdk.NewDec(2))}, true},
+		{"duplicate denom", FeeTokens{NewFeeToken("btc", sdk.NewDec(2)), NewFeeToken("btc", sdk.NewDec(3))}, true},
+		{"zero rate", FeeTokens{NewFeeToken("btc", sdk.ZeroDec())}, true},
+		{"negative rate", FeeTokens{NewFeeToken("btc", sdk.NewDec(-1))}, true},
+		{"nil rate", FeeTokens{FeeToken{Denom: "btc"}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.feeTokens.Validate()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestFeeTokensIsAccepted(t *testing.T) {
+	feeTokens := FeeTokens{NewFeeToken("btc", sdk.NewDec(2))}
+
+	require.True(t, feeTokens.IsAccepted(sdk.Coins{}, sdk.DefaultBondDenom))
+	require.True(t, feeTokens.IsAccepted(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), sdk.DefaultBondDenom))
+	require.True(t, feeTokens.IsAccepted(sdk.NewCoins(sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), sdk.DefaultBondDenom))
+	require.False(t, feeTokens.IsAccepted(sdk.NewCoins(sdk.NewInt64Coin("eth", 10)), sdk.DefaultBondDenom))
+	require.False(t, feeTokens.IsAccepted(sdk.NewCoins(sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("eth", 10)), sdk.DefaultBondDenom))
+
+	// the bond denom is the one given
+	require.True(t, feeTokens.IsAccepted(sdk.NewCoins(sdk.NewInt64Coin("eth", 10)), "eth"))
+	require.False(t, feeTokens.IsAccepted(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), "eth"))
+}
+
+func TestFeeTokensConvertToBondDenom(t *testing.T) {
+	feeTokens := FeeTokens{NewFeeToken("btc", sdk.NewDec(2)), NewFeeToken("eth", sdk.NewDecWithPrec(5, 1))}
+
+	// whitelisted tokens are converted and added to the bond denom amount
+	fees := sdk.NewCoins(sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("eth", 5), sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)), feeTokens.ConvertToBondDenom(fees, sdk.DefaultBondDenom))
+
+	// other tokens are kept as is
+	fees = sdk.NewCoins(sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("photino", 5))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("photino", 5), sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), feeTokens.ConvertToBondDenom(fees, sdk.DefaultBondDenom))
+
+	// converted amounts are truncated
+	fees = sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+	require.True(t, feeTokens.ConvertToBondDenom(fees, sdk.DefaultBondDenom).IsZero())
+
+	// tokens are converted to the given bond denom, which is never converted
+	fees = sdk.NewCoins(sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("eth", 5))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("eth", 25)), feeTokens.ConvertToBondDenom(fees, "eth"))
+}
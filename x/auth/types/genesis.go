@@ -6,17 +6,18 @@ import (
 
 // GenesisState - all auth state that must be provided at genesis
 type GenesisState struct {
-	Params Params `json:"params" yaml:"params"`
+	Params    Params    `json:"params" yaml:"params"`
+	FeeTokens FeeTokens `json:"fee_tokens" yaml:"fee_tokens"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params) GenesisState {
-	return GenesisState{params}
+func NewGenesisState(params Params, feeTokens FeeTokens) GenesisState {
+	return GenesisState{params, feeTokens}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams())
+	return NewGenesisState(DefaultParams(), FeeTokens{})
 }
 
 // ValidateGenesis performs basic validation of auth genesis data returning an
@@ -37,5 +38,8 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.TxSizeCostPerByte == 0 {
 		return fmt.Errorf("invalid tx size cost per byte: %d", data.Params.TxSizeCostPerByte)
 	}
+	if err := data.FeeTokens.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
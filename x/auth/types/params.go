@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/x/params/subspace"
 )
 
@@ -27,6 +28,7 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyFeeTokens              = []byte("FeeTokens")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -53,9 +55,20 @@ func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
 	}
 }
 
-// ParamKeyTable for auth module
+// ParamKeyTable for auth module. The fee token whitelist is registered on its
+// own as it is only set once the fee token upgrade is applied.
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).
+		RegisterType(KeyFeeTokens, FeeTokens{}).
+		RegisterValidator(KeyFeeTokens, validateFeeTokens)
+}
+
+func validateFeeTokens(_ sdk.Context, i interface{}) error {
+	feeTokens, ok := i.(FeeTokens)
+	if !ok {
+		return fmt.Errorf("invalid fee tokens type: %T", i)
+	}
+	return feeTokens.Validate()
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
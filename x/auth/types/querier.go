@@ -6,7 +6,8 @@ import (
 
 // query endpoints supported by the auth Querier
 const (
-	QueryAccount = "account"
+	QueryAccount   = "account"
+	QueryFeeTokens = "fee_tokens"
 )
 
 // QueryAccountParams defines the params for querying accounts.
@@ -112,9 +112,14 @@ func (tx StdTx) GetSignatures() []StdSignature { return tx.Signatures }
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+//
+// FeePayer is optional; when set to an account other than the first signer,
+// the fee is paid by that account out of a fee allowance it granted to the
+// first signer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount   sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas      uint64         `json:"gas" yaml:"gas"`
+	FeePayer sdk.AccAddress `json:"fee_payer,omitempty" yaml:"fee_payer"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -125,6 +130,12 @@ func NewStdFee(gas uint64, amount sdk.Coins) StdFee {
 	}
 }
 
+// WithFeePayer returns a copy of the fee with an updated fee payer.
+func (fee StdFee) WithFeePayer(feePayer sdk.AccAddress) StdFee {
+	fee.FeePayer = feePayer
+	return fee
+}
+
 // Bytes for signing later
 func (fee StdFee) Bytes() []byte {
 	// normalize. XXX
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// TxBundleSigner is a signer of a bundled transaction along with the account
+// number and the sequence its signature commits to
+type TxBundleSigner struct {
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
+	Sequence      uint64         `json:"sequence" yaml:"sequence"`
+}
+
+// NewTxBundleSigner returns a new TxBundleSigner
+func NewTxBundleSigner(addr sdk.AccAddress, accNum, seq uint64) TxBundleSigner {
+	return TxBundleSigner{
+		Address:       addr,
+		AccountNumber: accNum,
+		Sequence:      seq,
+	}
+}
+
+// TxBundle is a transaction bundled with everything needed to sign it offline:
+// the chain ID, and the account number and sequence of each of its signers, in
+// the order of their signatures. A signature left empty is still missing.
+type TxBundle struct {
+	ChainID string           `json:"chain_id" yaml:"chain_id"`
+	Signers []TxBundleSigner `json:"signers" yaml:"signers"`
+	Tx      StdTx            `json:"tx" yaml:"tx"`
+}
+
+// NewTxBundle returns a new TxBundle
+func NewTxBundle(chainID string, signers []TxBundleSigner, tx StdTx) TxBundle {
+	return TxBundle{
+		ChainID: chainID,
+		Signers: signers,
+		Tx:      tx,
+	}
+}
+
+// ValidateBasic checks that the signers of the bundle are the ones of its
+// transaction
+func (b TxBundle) ValidateBasic() error {
+	if strings.TrimSpace(b.ChainID) == "" {
+		return errors.New("chain ID required but not specified")
+	}
+
+	signers := b.Tx.GetSigners()
+	if len(signers) != len(b.Signers) {
+		return fmt.Errorf("wrong number of signers, expected %d, got %d", len(signers), len(b.Signers))
+	}
+	for i, signer := range signers {
+		if !signer.Equals(b.Signers[i].Address) {
+			return fmt.Errorf("wrong signer %d, expected %s, got %s", i, signer, b.Signers[i].Address)
+		}
+	}
+
+	if len(b.Tx.Signatures) > len(b.Signers) {
+		return fmt.Errorf("too many signatures, expected at most %d, got %d", len(b.Signers), len(b.Tx.Signatures))
+	}
+	return nil
+}
+
+// SignerIndex returns the index of an address in the signers of the bundle, or
+// -1 if it is not one of them
+func (b TxBundle) SignerIndex(addr sdk.AccAddress) int {
+	for i, signer := range b.Signers {
+		if bytes.Equal(signer.Address, addr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// StdSignMsg returns the message signed by the signer of the given index
+func (b TxBundle) StdSignMsg(i int) StdSignMsg {
+	return StdSignMsg{
+		ChainID:       b.ChainID,
+		AccountNumber: b.Signers[i].AccountNumber,
+		Sequence:      b.Signers[i].Sequence,
+		Fee:           b.Tx.Fee,
+		Msgs:          b.Tx.GetMsgs(),
+		Memo:          b.Tx.GetMemo(),
+	}
+}
+
+// WithSignature returns a copy of the bundle with the signature of the signer
+// of the given index, the signatures of the previous signers being left empty
+// if they are missing
+func (b TxBundle) WithSignature(i int, sig StdSignature) TxBundle {
+	sigs := make([]StdSignature, len(b.Tx.Signatures))
+	copy(sigs, b.Tx.Signatures)
+	for len(sigs) <= i {
+		sigs = append(sigs, StdSignature{})
+	}
+	sigs[i] = sig
+
+	b.Tx = NewStdTx(b.Tx.GetMsgs(), b.Tx.Fee, sigs, b.Tx.GetMemo())
+	return b
+}
+
+// IsSigned returns true if every signer signed the bundle
+func (b TxBundle) IsSigned() bool {
+	if len(b.Tx.Signatures) != len(b.Signers) {
+		return false
+	}
+	for _, sig := range b.Tx.Signatures {
+		if len(sig.Signature) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifySignatures checks the signatures of the bundle, the missing ones being
+// skipped
+func (b TxBundle) VerifySignatures() error {
+	if err := b.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for i, sig := range b.Tx.Signatures {
+		if len(sig.Signature) == 0 {
+			continue
+		}
+		if sig.PubKey == nil || !bytes.Equal(sig.PubKey.Address(), b.Signers[i].Address) {
+			return fmt.Errorf("signature %d does not match its signer %s", i, b.Signers[i].Address)
+		}
+		if !sig.VerifyBytes(b.StdSignMsg(i).Bytes(), sig.Signature) {
+			return fmt.Errorf("invalid signature %d of signer %s", i, b.Signers[i].Address)
+		}
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface
+func (b TxBundle) String() string {
+	var signers strings.Builder
+	for _, signer := range b.Signers {
+		signers.WriteString(fmt.Sprintf("\n    %s: account number %d, sequence %d",
+			signer.Address, signer.AccountNumber, signer.Sequence))
+	}
+
+	return fmt.Sprintf(`TxBundle:
+  Chain ID: %s
+  Signers:%s
+  Signed:   %t`, b.ChainID, signers.String(), b.IsSigned())
+}
+
+// TxBundles is a batch of transaction bundles
+type TxBundles []TxBundle
+
+// String implements the fmt.Stringer interface
+func (bs TxBundles) String() string {
+	out := make([]string, len(bs))
+	for i, b := range bs {
+		out[i] = b.String()
+	}
+	return strings.Join(out, "\n")
+}
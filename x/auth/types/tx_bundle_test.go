@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+func TestTxBundle(t *testing.T) {
+	priv1, _, addr1 := KeyTestPubAddr()
+	priv2, _, addr2 := KeyTestPubAddr()
+	_, _, addr3 := KeyTestPubAddr()
+
+	msgs := []sdk.Msg{NewTestMsg(addr1, addr2)}
+	tx := NewStdTx(msgs, NewTestStdFee(), nil, "memo")
+	signers := []TxBundleSigner{NewTxBundleSigner(addr1, 3, 10), NewTxBundleSigner(addr2, 4, 20)}
+	bundle := NewTxBundle("test-chain", signers, tx)
+	require.NoError(t, bundle.ValidateBasic())
+	require.False(t, bundle.IsSigned())
+	require.Equal(t, 1, bundle.SignerIndex(addr2))
+	require.Equal(t, -1, bundle.SignerIndex(addr3))
+
+	// the bundle must carry the chain ID and the signers of its transaction, in order
+	require.Error(t, NewTxBundle("", signers, tx).ValidateBasic())
+	require.Error(t, NewTxBundle("test-chain", signers[:1], tx).ValidateBasic())
+	require.Error(t, NewTxBundle("test-chain", []TxBundleSigner{signers[1], signers[0]}, tx).ValidateBasic())
+
+	sign := func(i int, priv interface {
+		Sign([]byte) ([]byte, error)
+	}) []byte {
+		sig, err := priv.Sign(bundle.StdSignMsg(i).Bytes())
+		require.NoError(t, err)
+		return sig
+	}
+
+	// the second signer signs first, leaving the first signature empty
+	sig2 := StdSignature{PubKey: priv2.PubKey(), Signature: sign(1, priv2)}
+	bundle = bundle.WithSignature(1, sig2)
+	require.Len(t, bundle.Tx.Signatures, 2)
+	require.Empty(t, bundle.Tx.Signatures[0].Signature)
+	require.False(t, bundle.IsSigned())
+	require.NoError(t, bundle.VerifySignatures())
+
+	sig1 := StdSignature{PubKey: priv1.PubKey(), Signature: sign(0, priv1)}
+	signed := bundle.WithSignature(0, sig1)
+	require.True(t, signed.IsSigned())
+	require.NoError(t, signed.VerifySignatures())
+	require.False(t, bundle.IsSigned(), "the original bundle must be left as is")
+
+	// the signatures commit to the account number and sequence of their signer
+	signed.Signers[0].Sequence++
+	require.Error(t, signed.VerifySignatures())
+	signed.Signers[0].Sequence--
+
+	// a signature must match its signer
+	require.Error(t, signed.WithSignature(0, sig2).VerifySignatures())
+}
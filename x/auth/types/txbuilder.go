@@ -26,6 +26,9 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feePayer           sdk.AccAddress
+	autoFees           bool
+	feeDenom           string
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -67,9 +70,21 @@ func NewTxBuilderFromCLI() TxBuilder {
 		memo:               viper.GetString(flags.FlagMemo),
 	}
 
-	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
+	if fees := viper.GetString(flags.FlagFees); fees == flags.FeesFlagAuto {
+		txbldr = txbldr.WithAutoFees(viper.GetString(flags.FlagFeeDenom))
+	} else {
+		txbldr = txbldr.WithFees(fees)
+	}
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 
+	if feePayer := viper.GetString(flags.FlagFeePayer); feePayer != "" {
+		addr, err := sdk.AccAddressFromBech32(feePayer)
+		if err != nil {
+			panic(err)
+		}
+		txbldr = txbldr.WithFeePayer(addr)
+	}
+
 	return txbldr
 }
 
@@ -101,12 +116,23 @@ func (bldr TxBuilder) ChainID() string { return bldr.chainID }
 // Memo returns the memo message
 func (bldr TxBuilder) Memo() string { return bldr.memo }
 
+// FeePayer returns the account paying the fees out of a fee allowance
+func (bldr TxBuilder) FeePayer() sdk.AccAddress { return bldr.feePayer }
+
 // Fees returns the fees for the transaction
 func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// AutoFees returns the option to compute the fees from the minimum gas prices
+// of the node
+func (bldr TxBuilder) AutoFees() bool { return bldr.autoFees }
+
+// FeeDenom returns the denom of the fees computed from the minimum gas prices
+// of the node, empty for the bond denom
+func (bldr TxBuilder) FeeDenom() string { return bldr.feeDenom }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -147,6 +173,64 @@ func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	return bldr
 }
 
+// WithAutoFees returns a copy of the context computing its fees in the given
+// denom from the minimum gas prices of the node, with the simulated gas.
+func (bldr TxBuilder) WithAutoFees(feeDenom string) TxBuilder {
+	bldr.autoFees = true
+	bldr.feeDenom = strings.TrimSpace(feeDenom)
+	bldr.simulateAndExecute = true
+	bldr.fees = nil
+	return bldr
+}
+
+// WithMinGasPricesFees returns a copy of the context with the fees of its gas
+// limit at the given minimum gas prices, in its fee denom or else in the given
+// bond denom. A fee token pays for the bond denom at its rate, so the minimum
+// gas prices must have one unless they are all zero.
+func (bldr TxBuilder) WithMinGasPricesFees(minGasPrices sdk.DecCoins, feeTokens FeeTokens,
+	bondDenom string) (TxBuilder, error) {
+
+	denom := bldr.feeDenom
+	if denom == "" {
+		denom = bondDenom
+	}
+	glDec := sdk.NewDec(int64(bldr.gas))
+
+	var fee sdk.Int
+	feeToken, isFeeToken := feeTokens.Get(denom)
+	bondPrice := minGasPrices.AmountOf(bondDenom)
+	switch {
+	case isFeeToken && bondPrice.IsPositive():
+		// the fee token is worth the bond denom fee once converted at its rate,
+		// which is truncated
+		bondFee := bondPrice.Mul(glDec).Ceil().RoundInt()
+		fee = bondFee.ToDec().Quo(feeToken.Rate).Ceil().RoundInt()
+		for feeToken.Rate.MulInt(fee).TruncateInt().LT(bondFee) {
+			fee = fee.AddRaw(1)
+		}
+
+	case minGasPrices.IsZero():
+		fee = sdk.ZeroInt()
+
+	case isFeeToken:
+		return bldr, fmt.Errorf("the node has no minimum gas price in %s to pay for in %s, its minimum gas prices are %s",
+			bondDenom, denom, minGasPrices)
+
+	case !minGasPrices.AmountOf(denom).IsZero():
+		fee = minGasPrices.AmountOf(denom).Mul(glDec).Ceil().RoundInt()
+
+	default:
+		return bldr, fmt.Errorf("the node has no minimum gas price in %s, its minimum gas prices are %s", denom, minGasPrices)
+	}
+
+	bldr.fees = nil
+	if fee.IsPositive() {
+		bldr.fees = sdk.NewCoins(sdk.NewCoin(denom, fee))
+	}
+	bldr.autoFees = false
+	return bldr, nil
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -165,6 +249,12 @@ func (bldr TxBuilder) WithMemo(memo string) TxBuilder {
 	return bldr
 }
 
+// WithFeePayer returns a copy of the context with an updated fee payer.
+func (bldr TxBuilder) WithFeePayer(feePayer sdk.AccAddress) TxBuilder {
+	bldr.feePayer = feePayer
+	return bldr
+}
+
 // WithAccountNumber returns a copy of the context with an account number.
 func (bldr TxBuilder) WithAccountNumber(accnum uint64) TxBuilder {
 	bldr.accountNumber = accnum
@@ -181,7 +271,7 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 
 	fees := bldr.fees
 	if !bldr.gasPrices.IsZero() {
-		if !fees.IsZero() {
+		if !fees.IsZero() || bldr.autoFees {
 			return StdSignMsg{}, errors.New("cannot provide both fees and gas prices")
 		}
 
@@ -202,7 +292,7 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		Fee:           NewStdFee(bldr.gas, fees).WithFeePayer(bldr.feePayer),
 	}, nil
 }
 
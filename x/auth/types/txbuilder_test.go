@@ -145,3 +145,66 @@ func TestTxBuilderBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestTxBuilderWithMinGasPricesFees(t *testing.T) {
+	feeTokens := FeeTokens{NewFeeToken("usdt", sdk.NewDecWithPrec(3, 1))}
+	bondPrice := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 3))}
+	atomPrice := sdk.DecCoins{sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(15, 1))}
+
+	tests := []struct {
+		name         string
+		feeDenom     string
+		minGasPrices sdk.DecCoins
+		feeTokens    FeeTokens
+		expected     sdk.Coins
+		expectErr    bool
+	}{
+		{"bond denom", "", bondPrice, nil, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2501)), false},
+		// 2501 bond denom at 0.3 per usdt, rounded up
+		{"fee token", "usdt", bondPrice, feeTokens, sdk.NewCoins(sdk.NewInt64Coin("usdt", 8337)), false},
+		{"other denom", "uatom", atomPrice, nil, sdk.NewCoins(sdk.NewInt64Coin("uatom", 150015)), false},
+		{"no minimum gas prices", "usdt", nil, feeTokens, nil, false},
+		{"unknown denom", "uatom", bondPrice, feeTokens, nil, true},
+		// fee tokens only pay for the bond denom
+		{"fee token without bond denom price", "usdt", atomPrice, feeTokens, nil, true},
+		{"fee token price", "usdt", sdk.DecCoins{sdk.NewDecCoinFromDec("usdt", sdk.OneDec())}, feeTokens, nil, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			bldr := NewTxBuilder(DefaultTxEncoder(codec.New()), 1, 1, 0, 1.0, false, "test-chain", "", nil, nil).
+				WithAutoFees(tt.feeDenom)
+			require.True(t, bldr.AutoFees())
+			require.True(t, bldr.SimulateAndExecute())
+
+			bldr, err := bldr.WithGas(100010).WithMinGasPricesFees(tt.minGasPrices, tt.feeTokens, sdk.DefaultBondDenom)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, bldr.AutoFees())
+			require.Equal(t, tt.expected, bldr.Fees())
+
+			// the fee tokens are worth the fees in bond denom once converted
+			if tt.expected != nil && tt.feeTokens != nil {
+				converted := tt.feeTokens.ConvertToBondDenom(bldr.Fees(), sdk.DefaultBondDenom)
+				require.True(t, converted.AmountOf(sdk.DefaultBondDenom).GTE(sdk.NewInt(2501)))
+			}
+		})
+	}
+
+	// the fees default to the given bond denom
+	stakePrice := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))}
+	bldr, err := NewTxBuilder(DefaultTxEncoder(codec.New()), 1, 1, 100000, 1.0, false, "test-chain", "", nil, nil).
+		WithAutoFees("").WithMinGasPricesFees(stakePrice, nil, "stake")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), bldr.Fees())
+
+	// auto fees cannot be combined with gas prices
+	bldr = NewTxBuilder(DefaultTxEncoder(codec.New()), 1, 1, 0, 1.0, false, "test-chain", "", nil, atomPrice).
+		WithAutoFees("")
+	_, err = bldr.BuildSignMsg([]sdk.Msg{sdk.NewTestMsg(addr)})
+	require.Error(t, err)
+}
@@ -0,0 +1,74 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/barkisnet/barkis/x/authz/internal/keeper
+// ALIASGEN: github.com/barkisnet/barkis/x/authz/internal/types
+package authz
+
+import (
+	"github.com/barkisnet/barkis/x/authz/internal/keeper"
+	"github.com/barkisnet/barkis/x/authz/internal/types"
+)
+
+const (
+	DefaultCodespace         = types.DefaultCodespace
+	CodeInvalidAuthorization = types.CodeInvalidAuthorization
+	CodeNoAuthorization      = types.CodeNoAuthorization
+	CodeAuthorizationExpired = types.CodeAuthorizationExpired
+	CodeLimitExceeded        = types.CodeLimitExceeded
+	CodeSelfGrant            = types.CodeSelfGrant
+	CodeInvalidExpiration    = types.CodeInvalidExpiration
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	RouterKey                = types.RouterKey
+	QuerierRoute             = types.QuerierRoute
+	QueryAuthorization       = types.QueryAuthorization
+	QueryAuthorizations      = types.QueryAuthorizations
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	RegisterCodec                = types.RegisterCodec
+	ErrInvalidAuthorization      = types.ErrInvalidAuthorization
+	ErrNoAuthorization           = types.ErrNoAuthorization
+	ErrAuthorizationExpired      = types.ErrAuthorizationExpired
+	ErrLimitExceeded             = types.ErrLimitExceeded
+	ErrSelfGrant                 = types.ErrSelfGrant
+	ErrInvalidExpiration         = types.ErrInvalidExpiration
+	NewGenericAuthorization      = types.NewGenericAuthorization
+	NewSendAuthorization         = types.NewSendAuthorization
+	NewMintAuthorization         = types.NewMintAuthorization
+	NewAuthorizationGrant        = types.NewAuthorizationGrant
+	NewMsgGrant                  = types.NewMsgGrant
+	NewMsgRevoke                 = types.NewMsgRevoke
+	NewMsgExec                   = types.NewMsgExec
+	NewQueryAuthorizationParams  = types.NewQueryAuthorizationParams
+	NewQueryAuthorizationsParams = types.NewQueryAuthorizationsParams
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	ValidateGenesis              = types.ValidateGenesis
+	GetGrantKey                  = types.GetGrantKey
+	GetGrantsKey                 = types.GetGrantsKey
+
+	// variable aliases
+	ModuleCdc      = types.ModuleCdc
+	GrantKeyPrefix = types.GrantKeyPrefix
+)
+
+type (
+	Keeper                    = keeper.Keeper
+	Authorization             = types.Authorization
+	GenericAuthorization      = types.GenericAuthorization
+	SendAuthorization         = types.SendAuthorization
+	MintAuthorization         = types.MintAuthorization
+	AuthorizationGrant        = types.AuthorizationGrant
+	AuthorizationGrants       = types.AuthorizationGrants
+	MsgGrant                  = types.MsgGrant
+	MsgRevoke                 = types.MsgRevoke
+	MsgExec                   = types.MsgExec
+	QueryAuthorizationParams  = types.QueryAuthorizationParams
+	QueryAuthorizationsParams = types.QueryAuthorizationsParams
+	GenesisState              = types.GenesisState
+)
@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/barkisnet/barkis/client"
+	"github.com/barkisnet/barkis/client/context"
+	"github.com/barkisnet/barkis/codec"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/auth"
+	"github.com/barkisnet/barkis/x/auth/client/utils"
+	"github.com/barkisnet/barkis/x/authz/internal/types"
+)
+
+const (
+	flagMsgType    = "msg-type"
+	flagSpendLimit = "spend-limit"
+	flagSymbol     = "symbol"
+	flagMintLimit  = "mint-limit"
+	flagExpiration = "expiration"
+
+	authorizationGeneric = "generic"
+	authorizationSend    = "send"
+	authorizationMint    = "mint"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authz transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(client.PostCommands(
+		GrantTxCmd(cdc),
+		RevokeTxCmd(cdc),
+		ExecTxCmd(cdc),
+	)...)
+	return txCmd
+}
+
+// GrantTxCmd will create a tx to grant an authorization to a grantee and sign
+// it with the given key.
+func GrantTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [generic|send|mint]",
+		Short: "Grant an account the authorization to execute msgs on your behalf",
+		Long: `Grant an account the authorization to execute msgs on your behalf.
+A generic authorization allows any msg of the type given by --msg-type. A send
+authorization allows sending up to --spend-limit, and a mint authorization
+allows minting up to --mint-limit of the token --symbol. The authorization may
+expire at the --expiration time (RFC3339).`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			authorization, err := buildAuthorization(args[1])
+			if err != nil {
+				return err
+			}
+
+			var expiration time.Time
+			if exp := viper.GetString(flagExpiration); exp != "" {
+				expiration, err = time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgGrant(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagMsgType, "", "Msg type allowed by a generic authorization, e.g. withdraw_delegator_reward")
+	cmd.Flags().String(flagSpendLimit, "", "Maximum amount of coins which can be sent with a send authorization")
+	cmd.Flags().String(flagSymbol, "", "Token which can be minted with a mint authorization")
+	cmd.Flags().Int64(flagMintLimit, 0, "Maximum amount of tokens which can be minted with a mint authorization")
+	cmd.Flags().String(flagExpiration, "", "Time at which the authorization expires (RFC3339); never if empty")
+
+	return cmd
+}
+
+// buildAuthorization builds an authorization of the given kind from the flags.
+func buildAuthorization(kind string) (types.Authorization, error) {
+	switch kind {
+	case authorizationGeneric:
+		return types.NewGenericAuthorization(viper.GetString(flagMsgType)), nil
+
+	case authorizationSend:
+		spendLimit, err := sdk.ParseCoins(viper.GetString(flagSpendLimit))
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSendAuthorization(spendLimit), nil
+
+	case authorizationMint:
+		return types.NewMintAuthorization(viper.GetString(flagSymbol), viper.GetInt64(flagMintLimit)), nil
+
+	default:
+		return nil, fmt.Errorf("unknown authorization %s, expected one of generic, send or mint", kind)
+	}
+}
+
+// RevokeTxCmd will create a tx to revoke the authorization granted to a
+// grantee for a msg type and sign it with the given key.
+func RevokeTxCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee] [msg_type]",
+		Short: "Revoke the authorization granted to an account for a msg type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevoke(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// ExecTxCmd will create a tx to execute the msgs of a generated transaction on
+// behalf of their signers and sign it with the given key.
+func ExecTxCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [tx_file]",
+		Short: "Execute the msgs of a transaction on behalf of their signers",
+		Long: `Execute the msgs of a transaction on behalf of their signers, which must
+have granted you an authorization for the msg types. The transaction file is
+created with the --generate-only flag of the msg's tx command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
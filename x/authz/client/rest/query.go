@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/barkisnet/barkis/client/context"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/types/rest"
+	"github.com/barkisnet/barkis/x/authz/internal/types"
+)
+
+// QueryAuthorizationRequestHandlerFn - http request handler to query the
+// authorization a granter granted to a grantee for a msg type.
+func QueryAuthorizationRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		granter, grantee, ok := parseGranterGrantee(w, vars)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, vars["msgType"]))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorization), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// QueryAuthorizationsRequestHandlerFn - http request handler to query all the
+// authorizations a granter granted to a grantee.
+func QueryAuthorizationsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		granter, grantee, ok := parseGranterGrantee(w, mux.Vars(r))
+		if !ok {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorizations), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func parseGranterGrantee(w http.ResponseWriter, vars map[string]string) (sdk.AccAddress, sdk.AccAddress, bool) {
+	granter, err := sdk.AccAddressFromBech32(vars["granter"])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(vars["grantee"])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	return granter, grantee, true
+}
@@ -0,0 +1,16 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/barkisnet/barkis/client/context"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/authz/grants/{grantee}", GrantRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/authz/grants/{grantee}/revoke", RevokeRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/authz/exec", ExecRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/authz/grants/{granter}/{grantee}", QueryAuthorizationsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/authz/grants/{granter}/{grantee}/{msgType}", QueryAuthorizationRequestHandlerFn(cliCtx)).Methods("GET")
+}
@@ -0,0 +1,156 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/barkisnet/barkis/client/context"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/types/rest"
+	"github.com/barkisnet/barkis/x/auth/client/utils"
+	"github.com/barkisnet/barkis/x/authz/internal/types"
+)
+
+// GrantReq defines the properties of a grant authorization request's body.
+type GrantReq struct {
+	BaseReq       rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	Authorization types.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time           `json:"expiration" yaml:"expiration"`
+}
+
+// GrantRequestHandlerFn - http request handler to grant an authorization to a
+// grantee.
+func GrantRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		grantee, err := sdk.AccAddressFromBech32(vars["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req GrantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgGrant(cliCtx.GetFromAddress(), grantee, req.Authorization, req.Expiration)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// RevokeReq defines the properties of a revoke authorization request's body.
+type RevokeReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	MsgType string       `json:"msg_type" yaml:"msg_type"`
+}
+
+// RevokeRequestHandlerFn - http request handler to revoke the authorization
+// granted to a grantee for a msg type.
+func RevokeRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		grantee, err := sdk.AccAddressFromBech32(vars["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req RevokeReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgRevoke(cliCtx.GetFromAddress(), grantee, req.MsgType)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// ExecReq defines the properties of an exec request's body.
+type ExecReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Msgs    []sdk.Msg    `json:"msgs" yaml:"msgs"`
+}
+
+// ExecRequestHandlerFn - http request handler to execute msgs on behalf of
+// their signers.
+func ExecRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ExecReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgExec(cliCtx.GetFromAddress(), req.Msgs)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// withFromFields derives the from account address and name of a request,
+// from the Keybase unless only generating the transaction, and sets them on
+// the context.
+func withFromFields(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq) (context.CLIContext, bool) {
+	var fromAddress sdk.AccAddress
+	var fromName string
+	var err error
+	if baseReq.GenerateOnly {
+		fromAddress, err = sdk.AccAddressFromBech32(baseReq.From)
+	} else {
+		fromAddress, fromName, err = context.GetFromFieldsFromAddr(baseReq.From)
+	}
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return cliCtx, false
+	}
+
+	return cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(baseReq.BroadcastMode), true
+}
@@ -0,0 +1,12 @@
+/*
+Package authz provides functionality for authorizing an account to execute
+msgs on behalf of another account.
+
+A granter grants a grantee an authorization for a msg type, as returned by
+sdk.Msg.Type. A generic authorization allows any msg of the type, while send
+and mint authorizations limit the coins sent or the tokens minted. The grantee
+wraps the msgs in a MsgExec; every signer of the msgs, as returned by
+sdk.Msg.GetSigners, other than the grantee must have authorized the grantee.
+The authorizations are checked and charged when the msgs are executed.
+*/
+package authz
@@ -0,0 +1,23 @@
+package authz
+
+import (
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// InitGenesis stores the authorizations of the genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, grant := range data.Authorizations {
+		k.Grant(ctx, grant)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var grants []AuthorizationGrant
+	k.IterateAllGrants(ctx, func(grant AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	return NewGenesisState(grants)
+}
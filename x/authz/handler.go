@@ -0,0 +1,93 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/authz/internal/keeper"
+	"github.com/barkisnet/barkis/x/authz/internal/types"
+)
+
+// NewHandler returns a handler for "authz" type messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgGrant:
+			return handleMsgGrant(ctx, k, msg)
+
+		case types.MsgRevoke:
+			return handleMsgRevoke(ctx, k, msg)
+
+		case types.MsgExec:
+			return handleMsgExec(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized authz message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// Handle MsgGrant.
+func handleMsgGrant(ctx sdk.Context, k keeper.Keeper, msg types.MsgGrant) sdk.Result {
+	if !msg.Expiration.IsZero() && !msg.Expiration.After(ctx.BlockHeader().Time) {
+		return types.ErrInvalidExpiration(k.Codespace()).Result()
+	}
+
+	grant := types.NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration)
+	k.Grant(ctx, grant)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msg.Authorization.MsgType()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgRevoke.
+func handleMsgRevoke(ctx sdk.Context, k keeper.Keeper, msg types.MsgRevoke) sdk.Result {
+	if err := k.Revoke(ctx, msg.Granter, msg.Grantee, msg.MsgType); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msg.MsgType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgExec.
+func handleMsgExec(ctx sdk.Context, k keeper.Keeper, msg types.MsgExec) sdk.Result {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+	)
+
+	return k.DispatchActions(ctx, msg.Grantee, msg.Msgs)
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/barkisnet/barkis/codec"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/authz/internal/types"
+)
+
+// Keeper manages the authorizations granted from one account to another, and
+// executes msgs on behalf of their granters.
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	router    sdk.Router
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new authz Keeper instance. The router is used to
+// dispatch the msgs executed on behalf of a granter.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, router sdk.Router, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		router:    router,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Grant stores an authorization, overwriting any previous authorization from
+// the same granter to the same grantee for the same msg type.
+func (k Keeper) Grant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetGrantKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType())
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+}
+
+// Revoke removes an existing authorization.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetGrantKey(granter, grantee, msgType)
+	if !store.Has(key) {
+		return types.ErrNoAuthorization(k.codespace, granter, grantee, msgType)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// GetGrant returns the authorization granter granted to grantee for a msg type.
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) (grant types.AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGrantKey(granter, grantee, msgType))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// IterateGrants iterates over all the authorizations granter granted to grantee.
+// The iteration stops when the callback returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, granter, grantee sdk.AccAddress,
+	cb func(grant types.AuthorizationGrant) (stop bool)) {
+
+	k.iterateGrants(ctx, types.GetGrantsKey(granter, grantee), cb)
+}
+
+// IterateAllGrants iterates over all the authorizations in the store.
+// The iteration stops when the callback returns true.
+func (k Keeper) IterateAllGrants(ctx sdk.Context, cb func(grant types.AuthorizationGrant) (stop bool)) {
+	k.iterateGrants(ctx, types.GrantKeyPrefix, cb)
+}
+
+func (k Keeper) iterateGrants(ctx sdk.Context, prefix []byte, cb func(grant types.AuthorizationGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// DispatchActions executes msgs on behalf of their signers. Every signer other
+// than the grantee must have authorized the grantee for the msg type; the
+// authorizations are charged, and removed once they are exhausted.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	var data []byte
+	for _, msg := range msgs {
+		if !sdk.GlobalUpgradeMgr.MsgCheck(msg.Type()) {
+			return sdk.ErrMsgNotSupported(fmt.Sprintf("%s will be supported after height %d",
+				msg.Type(), sdk.GlobalUpgradeMgr.GetMsgHeight(msg.Type()))).Result()
+		}
+
+		for _, signer := range msg.GetSigners() {
+			if signer.Equals(grantee) {
+				continue
+			}
+			if err := k.useGrant(ctx, signer, grantee, msg); err != nil {
+				return err.Result()
+			}
+		}
+
+		handler := k.router.Route(msg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest("unrecognized message route: " + msg.Route()).Result()
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+
+		data = append(data, res.Data...)
+		ctx.EventManager().EmitEvents(res.Events)
+	}
+
+	return sdk.Result{Data: data, Events: ctx.EventManager().Events()}
+}
+
+// useGrant charges msg against the authorization granter granted to grantee.
+func (k Keeper) useGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) sdk.Error {
+	grant, found := k.GetGrant(ctx, granter, grantee, msg.Type())
+	if !found || grant.Authorization == nil {
+		return types.ErrNoAuthorization(k.codespace, granter, grantee, msg.Type())
+	}
+	if grant.IsExpired(ctx.BlockHeader().Time) {
+		return types.ErrAuthorizationExpired(k.codespace)
+	}
+
+	remove, err := grant.Authorization.Accept(msg)
+	if err != nil {
+		return err
+	}
+
+	if remove {
+		// ignore the error as the grant is known to exist
+		_ = k.Revoke(ctx, granter, grantee, msg.Type())
+	} else {
+		k.Grant(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecAuthorized,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msg.Type()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/barkisnet/barkis/baseapp"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/authz/internal/types"
+	"github.com/barkisnet/barkis/x/bank"
+)
+
+var (
+	addr1 = sdk.AccAddress(crypto.AddressHash([]byte("addr1")))
+	addr2 = sdk.AccAddress(crypto.AddressHash([]byte("addr2")))
+	addr3 = sdk.AccAddress(crypto.AddressHash([]byte("addr3")))
+)
+
+func TestGrantRevoke(t *testing.T) {
+	_, ctx, keeper := SetupTestInput(baseapp.NewRouter())
+
+	send := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	generic := types.NewGenericAuthorization("withdraw_delegator_reward")
+
+	_, found := keeper.GetGrant(ctx, addr1, addr2, send.MsgType())
+	require.False(t, found)
+
+	keeper.Grant(ctx, types.NewAuthorizationGrant(addr1, addr2, send, time.Time{}))
+	keeper.Grant(ctx, types.NewAuthorizationGrant(addr1, addr2, generic, time.Time{}))
+	keeper.Grant(ctx, types.NewAuthorizationGrant(addr1, addr3, generic, time.Time{}))
+
+	grant, found := keeper.GetGrant(ctx, addr1, addr2, send.MsgType())
+	require.True(t, found)
+	require.Equal(t, send, grant.Authorization)
+
+	var grants types.AuthorizationGrants
+	keeper.IterateGrants(ctx, addr1, addr2, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 2)
+
+	count := 0
+	keeper.IterateAllGrants(ctx, func(grant types.AuthorizationGrant) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 3, count)
+
+	require.NoError(t, keeper.Revoke(ctx, addr1, addr2, send.MsgType()))
+	_, found = keeper.GetGrant(ctx, addr1, addr2, send.MsgType())
+	require.False(t, found)
+	require.Error(t, keeper.Revoke(ctx, addr1, addr2, send.MsgType()))
+}
+
+func TestDispatchActions(t *testing.T) {
+	executed := 0
+	router := baseapp.NewRouter()
+	router.AddRoute(bank.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		executed++
+		return sdk.Result{}
+	})
+
+	_, ctx, keeper := SetupTestInput(router)
+	now := time.Now()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	send := bank.MsgSend{FromAddress: addr1, ToAddress: addr3, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 60))}
+
+	// no authorization
+	require.False(t, keeper.DispatchActions(ctx, addr2, []sdk.Msg{send}).IsOK())
+	require.Equal(t, 0, executed)
+
+	// msgs signed by the grantee itself need no authorization
+	require.True(t, keeper.DispatchActions(ctx, addr1, []sdk.Msg{send}).IsOK())
+	require.Equal(t, 1, executed)
+
+	// the authorization is charged and removed once exhausted
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 120)))
+	keeper.Grant(ctx, types.NewAuthorizationGrant(addr1, addr2, authorization, time.Time{}))
+
+	require.True(t, keeper.DispatchActions(ctx, addr2, []sdk.Msg{send}).IsOK())
+	require.Equal(t, 2, executed)
+	grant, found := keeper.GetGrant(ctx, addr1, addr2, send.Type())
+	require.True(t, found)
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 60)).IsEqual(grant.Authorization.(*types.SendAuthorization).SpendLimit))
+
+	// the state changes of a failed tx are discarded
+	cacheCtx, _ := ctx.CacheContext()
+	require.False(t, keeper.DispatchActions(cacheCtx, addr2, []sdk.Msg{send, send}).IsOK())
+	require.True(t, keeper.DispatchActions(ctx, addr2, []sdk.Msg{send}).IsOK())
+	_, found = keeper.GetGrant(ctx, addr1, addr2, send.Type())
+	require.False(t, found)
+
+	// an expired authorization is rejected
+	keeper.Grant(ctx, types.NewAuthorizationGrant(addr1, addr2, types.NewGenericAuthorization(send.Type()), now))
+	require.False(t, keeper.DispatchActions(ctx, addr2, []sdk.Msg{send}).IsOK())
+}
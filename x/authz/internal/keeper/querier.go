@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/barkisnet/barkis/codec"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/authz/internal/types"
+)
+
+// NewQuerier returns a new querier for the authz module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if !sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.AuthzUpgrade) {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("authz related query is not supported until %d",
+				sdk.GlobalUpgradeMgr.GetUpgradeHeight(sdk.AuthzUpgrade)))
+		}
+
+		switch path[0] {
+		case types.QueryAuthorization:
+			return queryAuthorization(ctx, req, k)
+
+		case types.QueryAuthorizations:
+			return queryAuthorizations(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown authz query endpoint")
+		}
+	}
+}
+
+// queryAuthorization fetches the authorization a granter granted to a grantee
+// for a msg type.
+func queryAuthorization(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAuthorizationParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	grant, found := k.GetGrant(ctx, params.Granter, params.Grantee, params.MsgType)
+	if !found {
+		return nil, types.ErrNoAuthorization(k.codespace, params.Granter, params.Grantee, params.MsgType)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, grant)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryAuthorizations fetches all the authorizations a granter granted to a grantee.
+func queryAuthorizations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAuthorizationsParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	grants := types.AuthorizationGrants{}
+	k.IterateGrants(ctx, params.Granter, params.Grantee, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
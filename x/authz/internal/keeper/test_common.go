@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/barkisnet/barkis/codec"
+	"github.com/barkisnet/barkis/store"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/authz/internal/types"
+)
+
+func SetupTestInput(router sdk.Router) (*codec.Codec, sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+
+	authzKey := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authzKey, sdk.StoreTypeIAVL, db)
+	_ = ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
+	keeper := NewKeeper(cdc, authzKey, router, types.DefaultCodespace)
+
+	return cdc, ctx, keeper
+}
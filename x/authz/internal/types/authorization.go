@@ -0,0 +1,161 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/asset"
+	"github.com/barkisnet/barkis/x/bank"
+)
+
+// Authorization defines the permission for a grantee to execute one msg type
+// on behalf of a granter.
+type Authorization interface {
+	// MsgType returns the type of msg, as returned by sdk.Msg.Type, the
+	// authorization applies to.
+	MsgType() string
+
+	// Accept determines whether the msg can be executed. On success it updates
+	// the authorization in place, so the caller must persist it again.
+	//
+	// If remove is true the authorization is exhausted and should be deleted
+	// from the store.
+	Accept(msg sdk.Msg) (remove bool, err sdk.Error)
+
+	// ValidateBasic performs stateless validation of the authorization.
+	ValidateBasic() sdk.Error
+}
+
+var (
+	_ Authorization = (*GenericAuthorization)(nil)
+	_ Authorization = (*SendAuthorization)(nil)
+	_ Authorization = (*MintAuthorization)(nil)
+)
+
+// GenericAuthorization grants the permission to execute any msg of a type
+// without limit.
+type GenericAuthorization struct {
+	Msg string `json:"msg" yaml:"msg"`
+}
+
+// NewGenericAuthorization creates a new GenericAuthorization object
+func NewGenericAuthorization(msgType string) *GenericAuthorization {
+	return &GenericAuthorization{Msg: msgType}
+}
+
+// MsgType implements Authorization.
+func (a GenericAuthorization) MsgType() string { return a.Msg }
+
+// Accept implements Authorization.
+func (a *GenericAuthorization) Accept(msg sdk.Msg) (bool, sdk.Error) {
+	return false, nil
+}
+
+// ValidateBasic implements Authorization.
+func (a GenericAuthorization) ValidateBasic() sdk.Error {
+	if strings.TrimSpace(a.Msg) == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing msg type")
+	}
+	return nil
+}
+
+// String implements the stringer interface.
+func (a GenericAuthorization) String() string {
+	return fmt.Sprintf("Generic Authorization:\n  Msg Type: %s\n", a.Msg)
+}
+
+// SendAuthorization grants the permission to send coins from the granter's
+// account up to SpendLimit.
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+// NewSendAuthorization creates a new SendAuthorization object
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization.
+func (a SendAuthorization) MsgType() string { return bank.MsgSend{}.Type() }
+
+// Accept implements Authorization. The amount sent is deducted from SpendLimit.
+func (a *SendAuthorization) Accept(msg sdk.Msg) (bool, sdk.Error) {
+	send, ok := msg.(bank.MsgSend)
+	if !ok {
+		return false, ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("unexpected msg type %T", msg))
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(send.Amount)
+	if invalid {
+		return false, ErrLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s", send.Amount, a.SpendLimit))
+	}
+
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// ValidateBasic implements Authorization.
+func (a SendAuthorization) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() {
+		return sdk.ErrInvalidCoins("spend limit is invalid: " + a.SpendLimit.String())
+	}
+	if !a.SpendLimit.IsAllPositive() {
+		return sdk.ErrInvalidCoins("spend limit must be positive")
+	}
+	return nil
+}
+
+// String implements the stringer interface.
+func (a SendAuthorization) String() string {
+	return fmt.Sprintf("Send Authorization:\n  Spend Limit: %s\n", a.SpendLimit)
+}
+
+// MintAuthorization grants the permission to mint up to MintLimit of the
+// token Symbol issued by the granter.
+type MintAuthorization struct {
+	Symbol    string `json:"symbol" yaml:"symbol"`
+	MintLimit int64  `json:"mint_limit" yaml:"mint_limit"`
+}
+
+// NewMintAuthorization creates a new MintAuthorization object
+func NewMintAuthorization(symbol string, mintLimit int64) *MintAuthorization {
+	return &MintAuthorization{Symbol: symbol, MintLimit: mintLimit}
+}
+
+// MsgType implements Authorization.
+func (a MintAuthorization) MsgType() string { return asset.MintMsg{}.Type() }
+
+// Accept implements Authorization. The amount minted is deducted from MintLimit.
+func (a *MintAuthorization) Accept(msg sdk.Msg) (bool, sdk.Error) {
+	mint, ok := msg.(asset.MintMsg)
+	if !ok {
+		return false, ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("unexpected msg type %T", msg))
+	}
+
+	if mint.Symbol != a.Symbol {
+		return false, ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("token %s is not authorized", mint.Symbol))
+	}
+	if mint.Amount > a.MintLimit {
+		return false, ErrLimitExceeded(DefaultCodespace, fmt.Sprintf("%d > %d", mint.Amount, a.MintLimit))
+	}
+
+	a.MintLimit -= mint.Amount
+	return a.MintLimit == 0, nil
+}
+
+// ValidateBasic implements Authorization.
+func (a MintAuthorization) ValidateBasic() sdk.Error {
+	if strings.TrimSpace(a.Symbol) == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing token symbol")
+	}
+	if a.MintLimit <= 0 {
+		return ErrInvalidAuthorization(DefaultCodespace, "mint limit must be positive")
+	}
+	return nil
+}
+
+// String implements the stringer interface.
+func (a MintAuthorization) String() string {
+	return fmt.Sprintf("Mint Authorization:\n  Symbol:     %s\n  Mint Limit: %d\n", a.Symbol, a.MintLimit)
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/asset"
+	"github.com/barkisnet/barkis/x/bank"
+)
+
+var (
+	granter = sdk.AccAddress([]byte("granter"))
+	grantee = sdk.AccAddress([]byte("grantee"))
+)
+
+func TestGenericAuthorization(t *testing.T) {
+	authorization := NewGenericAuthorization("withdraw_delegator_reward")
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "withdraw_delegator_reward", authorization.MsgType())
+
+	remove, err := authorization.Accept(bank.MsgSend{FromAddress: granter, ToAddress: grantee, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))})
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	require.Error(t, NewGenericAuthorization(" ").ValidateBasic())
+}
+
+func TestSendAuthorization(t *testing.T) {
+	authorization := NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, bank.MsgSend{}.Type(), authorization.MsgType())
+
+	send := bank.MsgSend{FromAddress: granter, ToAddress: grantee, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 60))}
+
+	remove, err := authorization.Accept(send)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 40)).IsEqual(authorization.SpendLimit))
+
+	_, err = authorization.Accept(send)
+	require.Error(t, err)
+
+	remove, err = authorization.Accept(bank.MsgSend{FromAddress: granter, ToAddress: grantee, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 40))})
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	_, err = authorization.Accept(asset.MintMsg{From: granter, Symbol: "btc", Amount: 10})
+	require.Error(t, err)
+
+	require.Error(t, NewSendAuthorization(nil).ValidateBasic())
+}
+
+func TestMintAuthorization(t *testing.T) {
+	authorization := NewMintAuthorization("btc", 100)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, asset.MintMsg{}.Type(), authorization.MsgType())
+
+	remove, err := authorization.Accept(asset.MintMsg{From: granter, Symbol: "btc", Amount: 60})
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, int64(40), authorization.MintLimit)
+
+	_, err = authorization.Accept(asset.MintMsg{From: granter, Symbol: "btc", Amount: 60})
+	require.Error(t, err)
+
+	_, err = authorization.Accept(asset.MintMsg{From: granter, Symbol: "eth", Amount: 10})
+	require.Error(t, err)
+
+	remove, err = authorization.Accept(asset.MintMsg{From: granter, Symbol: "btc", Amount: 40})
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	require.Error(t, NewMintAuthorization("", 100).ValidateBasic())
+	require.Error(t, NewMintAuthorization("btc", 0).ValidateBasic())
+}
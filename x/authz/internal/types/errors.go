@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// Authz errors reserve 100 ~ 199.
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidAuthorization sdk.CodeType = 101
+	CodeNoAuthorization      sdk.CodeType = 102
+	CodeAuthorizationExpired sdk.CodeType = 103
+	CodeLimitExceeded        sdk.CodeType = 104
+	CodeSelfGrant            sdk.CodeType = 105
+	CodeInvalidExpiration    sdk.CodeType = 106
+)
+
+// ErrInvalidAuthorization is an error
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAuthorization, fmt.Sprintf("invalid authorization: %s", msg))
+}
+
+// ErrNoAuthorization is an error
+func ErrNoAuthorization(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAuthorization,
+		fmt.Sprintf("no authorization from %s to %s for msg type %s", granter, grantee, msgType))
+}
+
+// ErrAuthorizationExpired is an error
+func ErrAuthorizationExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAuthorizationExpired, "authorization expired")
+}
+
+// ErrLimitExceeded is an error
+func ErrLimitExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeLimitExceeded, fmt.Sprintf("authorization limit exceeded: %s", msg))
+}
+
+// ErrSelfGrant is an error
+func ErrSelfGrant(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSelfGrant, "granter and grantee cannot be the same account")
+}
+
+// ErrInvalidExpiration is an error
+func ErrInvalidExpiration(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExpiration, "expiration must be after the current block time")
+}
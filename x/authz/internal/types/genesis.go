@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState contains a set of authorization grants, persisted from the store
+type GenesisState struct {
+	Authorizations []AuthorizationGrant `json:"authorizations" yaml:"authorizations"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(authorizations []AuthorizationGrant) GenesisState {
+	return GenesisState{Authorizations: authorizations}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]AuthorizationGrant{})
+}
+
+// ValidateGenesis performs basic validation of authz genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool)
+	for _, grant := range data.Authorizations {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(GetGrantKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType()))
+		if seen[key] {
+			return fmt.Errorf("duplicate authorization from %s to %s for msg type %s",
+				grant.Granter, grant.Grantee, grant.Authorization.MsgType())
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// AuthorizationGrant is stored in the KVStore to record an authorization with
+// full context. A zero Expiration never expires.
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Authorization Authorization  `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewAuthorizationGrant creates a new AuthorizationGrant object
+func NewAuthorizationGrant(granter, grantee sdk.AccAddress, authorization Authorization,
+	expiration time.Time) AuthorizationGrant {
+
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// IsExpired returns true if the grant has expired at the given block time.
+func (g AuthorizationGrant) IsExpired(blockTime time.Time) bool {
+	return !g.Expiration.IsZero() && !blockTime.Before(g.Expiration)
+}
+
+// ValidateBasic performs basic validation of the grant
+func (g AuthorizationGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return ErrSelfGrant(DefaultCodespace)
+	}
+	if g.Authorization == nil {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing authorization")
+	}
+	return g.Authorization.ValidateBasic()
+}
+
+// String implements the stringer interface.
+func (g AuthorizationGrant) String() string {
+	return fmt.Sprintf(`Granter:    %s
+Grantee:    %s
+Expiration: %s
+%s`, g.Granter, g.Grantee, g.Expiration, g.Authorization)
+}
+
+// AuthorizationGrants is a collection of AuthorizationGrant
+type AuthorizationGrants []AuthorizationGrant
+
+// String implements the stringer interface.
+func (gs AuthorizationGrants) String() string {
+	if len(gs) == 0 {
+		return "[]"
+	}
+
+	out := ""
+	for _, g := range gs {
+		out += g.String() + "\n"
+	}
+	return out
+}
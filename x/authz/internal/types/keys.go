@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authorizations
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authorizations
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authorizations
+	QuerierRoute = ModuleName
+)
+
+var (
+	// GrantKeyPrefix is the set of the kvstore for authorization grant data
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GetGrantKey is the key used to store the authorization of a msg type, which
+// is indexed by grantee and granter so that all authorizations between two
+// accounts can be iterated.
+func GetGrantKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetGrantsKey(granter, grantee), []byte(msgType)...)
+}
+
+// GetGrantsKey is the prefix of all authorizations a granter granted to a grantee
+func GetGrantsKey(granter, grantee sdk.AccAddress) []byte {
+	key := append(GrantKeyPrefix, grantee.Bytes()...)
+	return append(key, granter.Bytes()...)
+}
@@ -0,0 +1,163 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// MsgGrant grants Grantee the permission to execute the msg type of
+// Authorization on behalf of Granter, until Expiration.
+type MsgGrant struct {
+	Granter       sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Authorization Authorization  `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+var _ sdk.Msg = MsgGrant{}
+
+// NewMsgGrant - construct a msg to grant an authorization.
+func NewMsgGrant(granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time) MsgGrant {
+	return MsgGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgGrant) Type() string { return "grant_authorization" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgGrant) ValidateBasic() sdk.Error {
+	return NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration).ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevoke revokes the authorization Granter granted to Grantee for MsgType.
+type MsgRevoke struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+var _ sdk.Msg = MsgRevoke{}
+
+// NewMsgRevoke - construct a msg to revoke an authorization.
+func NewMsgRevoke(granter, grantee sdk.AccAddress, msgType string) MsgRevoke {
+	return MsgRevoke{Granter: granter, Grantee: grantee, MsgType: msgType}
+}
+
+// Route Implements Msg.
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRevoke) Type() string { return "revoke_authorization" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevoke) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if strings.TrimSpace(msg.MsgType) == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing msg type")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExec executes Msgs on behalf of their signers. Every signer of the msgs
+// other than Grantee must have granted Grantee an authorization for the msg type.
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs" yaml:"msgs"`
+}
+
+var _ sdk.Msg = MsgExec{}
+
+// NewMsgExec - construct a msg to execute msgs on behalf of their signers.
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{Grantee: grantee, Msgs: msgs}
+}
+
+// Route Implements Msg.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgExec) Type() string { return "exec_authorized" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdk.ErrUnknownRequest("no msgs to execute")
+	}
+	for i, m := range msg.Msgs {
+		if m == nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("msg %d is empty", i))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg. The sign bytes of the msgs to execute are
+// embedded, as the module codec does not know every msg type.
+func (msg MsgExec) GetSignBytes() []byte {
+	msgs := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgs[i] = json.RawMessage(m.GetSignBytes())
+	}
+
+	bz, err := json.Marshal(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{msg.Grantee, msgs})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// GetMessages returns the msgs to execute.
+func (msg MsgExec) GetMessages() []sdk.Msg {
+	return msg.Msgs
+}
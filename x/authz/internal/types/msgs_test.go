@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/bank"
+)
+
+func TestMsgGrantValidation(t *testing.T) {
+	authorization := NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+
+	cases := []struct {
+		valid bool
+		msg   MsgGrant
+	}{
+		{true, NewMsgGrant(granter, grantee, authorization, time.Time{})},
+		{true, NewMsgGrant(granter, grantee, authorization, time.Now())},
+		{false, NewMsgGrant(nil, grantee, authorization, time.Time{})},
+		{false, NewMsgGrant(granter, nil, authorization, time.Time{})},
+		{false, NewMsgGrant(granter, granter, authorization, time.Time{})},
+		{false, NewMsgGrant(granter, grantee, nil, time.Time{})},
+		{false, NewMsgGrant(granter, grantee, NewSendAuthorization(sdk.Coins{}), time.Time{})},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		require.Equal(t, tc.valid, err == nil, "case %d", i)
+	}
+}
+
+func TestMsgRevokeValidation(t *testing.T) {
+	require.NoError(t, NewMsgRevoke(granter, grantee, "send").ValidateBasic())
+	require.Error(t, NewMsgRevoke(nil, grantee, "send").ValidateBasic())
+	require.Error(t, NewMsgRevoke(granter, nil, "send").ValidateBasic())
+	require.Error(t, NewMsgRevoke(granter, grantee, "").ValidateBasic())
+}
+
+func TestMsgExec(t *testing.T) {
+	send := bank.MsgSend{FromAddress: granter, ToAddress: grantee, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+
+	msg := NewMsgExec(grantee, []sdk.Msg{send})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+	require.Contains(t, string(msg.GetSignBytes()), string(send.GetSignBytes()))
+
+	require.Error(t, NewMsgExec(nil, []sdk.Msg{send}).ValidateBasic())
+	require.Error(t, NewMsgExec(grantee, nil).ValidateBasic())
+	require.Error(t, NewMsgExec(grantee, []sdk.Msg{bank.MsgSend{FromAddress: granter, ToAddress: grantee, Amount: sdk.Coins{}}}).ValidateBasic())
+}
@@ -13,7 +13,11 @@ const (
 	DefaultCodespace         = types.DefaultCodespace
 	CodeSendDisabled         = types.CodeSendDisabled
 	CodeInvalidInputsOutputs = types.CodeInvalidInputsOutputs
+	CodeMemoRequired         = types.CodeMemoRequired
+	CodeInvalidVesting       = types.CodeInvalidVesting
+	CodeAccountExists        = types.CodeAccountExists
 	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
 	RouterKey                = types.RouterKey
 	QuerierRoute             = types.QuerierRoute
 	DefaultParamspace        = types.DefaultParamspace
@@ -21,15 +25,21 @@ const (
 
 var (
 	// functions aliases
-	RegisterCodec          = types.RegisterCodec
-	ErrNoInputs            = types.ErrNoInputs
-	ErrNoOutputs           = types.ErrNoOutputs
-	ErrInputOutputMismatch = types.ErrInputOutputMismatch
-	ErrSendDisabled        = types.ErrSendDisabled
-	NewBaseKeeper          = keeper.NewBaseKeeper
-	NewInput               = types.NewInput
-	NewOutput              = types.NewOutput
-	ParamKeyTable          = types.ParamKeyTable
+	RegisterCodec                      = types.RegisterCodec
+	ErrNoInputs                        = types.ErrNoInputs
+	ErrNoOutputs                       = types.ErrNoOutputs
+	ErrInputOutputMismatch             = types.ErrInputOutputMismatch
+	ErrSendDisabled                    = types.ErrSendDisabled
+	ErrMemoRequired                    = types.ErrMemoRequired
+	ErrInvalidVestingSchedule          = types.ErrInvalidVestingSchedule
+	ErrAccountExists                   = types.ErrAccountExists
+	NewBaseKeeper                      = keeper.NewBaseKeeper
+	NewInput                           = types.NewInput
+	NewOutput                          = types.NewOutput
+	NewMsgSetMemoRequired              = types.NewMsgSetMemoRequired
+	NewMsgCreateVestingAccount         = types.NewMsgCreateVestingAccount
+	NewMsgCreatePeriodicVestingAccount = types.NewMsgCreatePeriodicVestingAccount
+	ParamKeyTable                      = types.ParamKeyTable
 
 	// variable aliases
 	ModuleCdc                = types.ModuleCdc
@@ -37,10 +47,13 @@ var (
 )
 
 type (
-	BaseKeeper   = keeper.BaseKeeper // ibc module depends on this
-	Keeper       = keeper.Keeper
-	MsgSend      = types.MsgSend
-	MsgMultiSend = types.MsgMultiSend
-	Input        = types.Input
-	Output       = types.Output
+	BaseKeeper                      = keeper.BaseKeeper // ibc module depends on this
+	Keeper                          = keeper.Keeper
+	MsgSend                         = types.MsgSend
+	MsgMultiSend                    = types.MsgMultiSend
+	MsgSetMemoRequired              = types.MsgSetMemoRequired
+	MsgCreateVestingAccount         = types.MsgCreateVestingAccount
+	MsgCreatePeriodicVestingAccount = types.MsgCreatePeriodicVestingAccount
+	Input                           = types.Input
+	Output                          = types.Output
 )
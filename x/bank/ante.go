@@ -0,0 +1,76 @@
+package bank
+
+import (
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/auth"
+	"github.com/barkisnet/barkis/x/bank/internal/keeper"
+	"github.com/barkisnet/barkis/x/bank/internal/types"
+)
+
+// NewMemoRequiredAnteHandler returns an AnteHandler that runs the given
+// AnteHandler first and then rejects any transaction with an empty memo that
+// sends coins to an account flagged as memo required.
+func NewMemoRequiredAnteHandler(k keeper.Keeper, anteHandler sdk.AnteHandler) sdk.AnteHandler {
+	return func(
+		ctx sdk.Context, tx sdk.Tx, simulate bool,
+	) (newCtx sdk.Context, res sdk.Result, abort bool) {
+
+		newCtx, res, abort = anteHandler(ctx, tx, simulate)
+		if abort || !sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.MemoRequiredUpgrade) {
+			return newCtx, res, abort
+		}
+
+		stdTx, ok := tx.(auth.StdTx)
+		if !ok {
+			return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
+		}
+
+		if err := ValidateMemoRequired(newCtx, k, stdTx); err != nil {
+			return newCtx, err.Result(), true
+		}
+
+		return newCtx, res, false
+	}
+}
+
+// msgsWrapper is implemented by the msgs executing other msgs, such as the
+// authz exec msg, so that their sends are checked as well
+type msgsWrapper interface {
+	GetMessages() []sdk.Msg
+}
+
+// ValidateMemoRequired returns an error if the memo of the transaction is empty
+// and any of its send outputs goes to an account flagged as memo required,
+// including the sends wrapped in other msgs.
+func ValidateMemoRequired(ctx sdk.Context, k keeper.Keeper, stdTx auth.StdTx) sdk.Error {
+	if len(stdTx.GetMemo()) != 0 {
+		return nil
+	}
+
+	return validateMemoRequiredMsgs(ctx, k, stdTx.GetMsgs())
+}
+
+func validateMemoRequiredMsgs(ctx sdk.Context, k keeper.Keeper, msgs []sdk.Msg) sdk.Error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case types.MsgSend:
+			if k.GetMemoRequired(ctx, msg.ToAddress) {
+				return types.ErrMemoRequired(k.Codespace(), msg.ToAddress)
+			}
+
+		case types.MsgMultiSend:
+			for _, out := range msg.Outputs {
+				if k.GetMemoRequired(ctx, out.Address) {
+					return types.ErrMemoRequired(k.Codespace(), out.Address)
+				}
+			}
+
+		case msgsWrapper:
+			if err := validateMemoRequiredMsgs(ctx, k, msg.GetMessages()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
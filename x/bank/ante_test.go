@@ -0,0 +1,60 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/auth"
+	"github.com/barkisnet/barkis/x/authz"
+	"github.com/barkisnet/barkis/x/bank"
+	"github.com/barkisnet/barkis/x/bank/internal/types"
+	"github.com/barkisnet/barkis/x/mock"
+)
+
+func TestValidateMemoRequired(t *testing.T) {
+	mapp := mock.NewApp()
+	keyBank := sdk.NewKVStoreKey(types.StoreKey)
+	keeper := bank.NewBaseKeeper(
+		keyBank, mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(types.DefaultParamspace),
+		types.DefaultCodespace, map[string]bool{},
+	)
+	require.NoError(t, mapp.CompleteSetup(keyBank))
+	mock.SetGenesis(mapp, []auth.Account{})
+
+	ctx := mapp.BaseApp.NewContext(true, abci.Header{})
+	keeper.SetMemoRequired(ctx, addr2, true)
+
+	cases := []struct {
+		msgs    []sdk.Msg
+		memo    string
+		expPass bool
+	}{
+		{[]sdk.Msg{sendMsg1}, "", false},
+		{[]sdk.Msg{sendMsg1}, "deposit-id", true},
+		{[]sdk.Msg{sendMsg2}, "", true},
+		{[]sdk.Msg{multiSendMsg1}, "", false},
+		{[]sdk.Msg{multiSendMsg3}, "", false},
+		{[]sdk.Msg{multiSendMsg4}, "", true},
+		{[]sdk.Msg{sendMsg2, multiSendMsg2}, "", false},
+		{[]sdk.Msg{types.NewMsgSetMemoRequired(addr1, true)}, "", true},
+		{[]sdk.Msg{authz.NewMsgExec(addr3, []sdk.Msg{sendMsg1})}, "", false},
+		{[]sdk.Msg{authz.NewMsgExec(addr3, []sdk.Msg{sendMsg1})}, "deposit-id", true},
+		{[]sdk.Msg{authz.NewMsgExec(addr3, []sdk.Msg{sendMsg2, multiSendMsg1})}, "", false},
+		{[]sdk.Msg{authz.NewMsgExec(addr3, []sdk.Msg{sendMsg2})}, "", true},
+	}
+
+	for i, tc := range cases {
+		stdTx := auth.NewStdTx(tc.msgs, freeFee, nil, tc.memo)
+		err := bank.ValidateMemoRequired(ctx, keeper, stdTx)
+		if tc.expPass {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+			require.Equal(t, bank.CodeMemoRequired, err.Code(), "case %d", i)
+		}
+	}
+}
@@ -45,7 +45,9 @@ func getBenchmarkMockApp() (*mock.App, error) {
 	blacklistedAddrs := make(map[string]bool)
 	blacklistedAddrs[moduleAccAddr.String()] = true
 
+	keyBank := sdk.NewKVStoreKey(types.StoreKey)
 	bankKeeper := keeper.NewBaseKeeper(
+		keyBank,
 		mapp.AccountKeeper,
 		mapp.ParamsKeeper.Subspace(types.DefaultParamspace),
 		types.DefaultCodespace,
@@ -54,7 +56,7 @@ func getBenchmarkMockApp() (*mock.App, error) {
 	mapp.Router().AddRoute(types.RouterKey, bank.NewHandler(bankKeeper))
 	mapp.SetInitChainer(getInitChainer(mapp, bankKeeper))
 
-	err := mapp.CompleteSetup()
+	err := mapp.CompleteSetup(keyBank)
 	return mapp, err
 }
 
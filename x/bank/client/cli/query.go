@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/barkisnet/barkis/client"
+	"github.com/barkisnet/barkis/client/context"
+	"github.com/barkisnet/barkis/codec"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/bank/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(client.GetCommands(
+		GetMemoRequiredCmd(queryRoute, cdc),
+	)...)
+	return queryCmd
+}
+
+// GetMemoRequiredCmd queries whether an account requires a memo on incoming
+// transactions.
+func GetMemoRequiredCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "memo-required [address]",
+		Short: "Query whether an account requires a memo on incoming transactions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryMemoRequiredParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/memo_required", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var memoRequired types.MemoRequired
+			if err := cdc.UnmarshalJSON(res, &memoRequired); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(memoRequired)
+		},
+	}
+}
@@ -1,7 +1,12 @@
 package cli
 
 import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/barkisnet/barkis/client"
 	"github.com/barkisnet/barkis/client/context"
@@ -12,6 +17,10 @@ import (
 	"github.com/barkisnet/barkis/x/bank/internal/types"
 )
 
+const (
+	flagDelayed = "delayed"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
@@ -23,6 +32,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(
 		SendTxCmd(cdc),
+		SetMemoRequiredTxCmd(cdc),
+		CreateVestingAccountTxCmd(cdc),
+		CreatePeriodicVestingAccountTxCmd(cdc),
 	)
 	return txCmd
 }
@@ -58,3 +70,122 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// SetMemoRequiredTxCmd will create a tx to set the memo required flag of the
+// sender account and sign it with the given key.
+func SetMemoRequiredTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-memo-required [true|false]",
+		Short: "Require (or stop requiring) a memo on transactions sending coins to your account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			required, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMemoRequired(cliCtx.GetFromAddress(), required)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CreateVestingAccountTxCmd will create a tx to create and fund a continuous
+// or delayed vesting account and sign it with the given key.
+func CreateVestingAccountTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new vesting account funded with an allocation of tokens",
+		Long: `Create a new vesting account funded with an allocation of tokens. The
+account can either be a delayed or continuous vesting account, which is determined
+by the '--delayed' flag. All vesting accounts created will have their start time
+set by the committed block's time. The end_time must be provided as a UNIX epoch
+timestamp.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingAccount(cliCtx.GetFromAddress(), to, amount, endTime, viper.GetBool(flagDelayed))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(flagDelayed, false, "Create a delayed vesting account if true")
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CreatePeriodicVestingAccountTxCmd will create a tx to create and fund a
+// periodic vesting account and sign it with the given key.
+func CreatePeriodicVestingAccountTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [start_time] [periods_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens",
+		Long: fmt.Sprintf(`Create a new periodic vesting account funded with the sum of the amounts
+of all vesting periods. The start_time must be provided as a UNIX epoch timestamp
+and the periods file must contain a JSON list of periods, each with a length in
+seconds relative to the end of the previous period, e.g.:
+
+[
+  {"length": "7776000", "amount": [{"denom": "%s", "amount": "1000"}]},
+  {"length": "7776000", "amount": [{"denom": "%s", "amount": "1000"}]}
+]
+`, sdk.DefaultBondDenom, sdk.DefaultBondDenom),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			var periods auth.Periods
+			if err := cdc.UnmarshalJSON(bz, &periods); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(cliCtx.GetFromAddress(), to, startTime, periods)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/barkisnet/barkis/client/context"
 	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/types/rest"
+	"github.com/barkisnet/barkis/x/auth"
 	"github.com/barkisnet/barkis/x/auth/client/utils"
 
 	"github.com/barkisnet/barkis/x/bank/internal/types"
@@ -17,6 +18,10 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/memo_required", SetMemoRequiredRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/memo_required/{address}", QueryMemoRequiredRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/accounts/{address}/vesting", CreateVestingAccountRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/accounts/{address}/periodic_vesting", CreatePeriodicVestingAccountRequestHandlerFn(cliCtx)).Methods("POST")
 }
 
 // SendReq defines the properties of a send request's body.
@@ -75,3 +80,147 @@ func SendRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// SetMemoRequiredReq defines the properties of a set memo required request's body.
+type SetMemoRequiredReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Required bool         `json:"required" yaml:"required"`
+}
+
+// SetMemoRequiredRequestHandlerFn - http request handler to set the memo
+// required flag of the sender account.
+func SetMemoRequiredRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetMemoRequiredReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		var fromAddress sdk.AccAddress
+		var fromName string
+		var err error
+		if req.BaseReq.GenerateOnly {
+			fromAddress, err = sdk.AccAddressFromBech32(req.BaseReq.From)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		} else {
+			fromAddress, fromName, err = context.GetFromFieldsFromAddr(req.BaseReq.From)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		cliCtx = cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(req.BaseReq.BroadcastMode)
+		msg := types.NewMsgSetMemoRequired(fromAddress, req.Required)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CreateVestingAccountReq defines the properties of a create vesting account
+// request's body.
+type CreateVestingAccountReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Amount  sdk.Coins    `json:"amount" yaml:"amount"`
+	EndTime int64        `json:"end_time" yaml:"end_time"`
+	Delayed bool         `json:"delayed" yaml:"delayed"`
+}
+
+// CreateVestingAccountRequestHandlerFn - http request handler to create a
+// continuous or delayed vesting account at a address.
+func CreateVestingAccountRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		toAddr, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CreateVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreateVestingAccount(cliCtx.GetFromAddress(), toAddr, req.Amount, req.EndTime, req.Delayed)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CreatePeriodicVestingAccountReq defines the properties of a create periodic
+// vesting account request's body.
+type CreatePeriodicVestingAccountReq struct {
+	BaseReq        rest.BaseReq `json:"base_req" yaml:"base_req"`
+	StartTime      int64        `json:"start_time" yaml:"start_time"`
+	VestingPeriods auth.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// CreatePeriodicVestingAccountRequestHandlerFn - http request handler to create
+// a periodic vesting account at a address.
+func CreatePeriodicVestingAccountRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		toAddr, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CreatePeriodicVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreatePeriodicVestingAccount(cliCtx.GetFromAddress(), toAddr, req.StartTime, req.VestingPeriods)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// withFromFields derives the from account address and name of a request,
+// from the Keybase unless only generating the transaction, and sets them on
+// the context.
+func withFromFields(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq) (context.CLIContext, bool) {
+	var fromAddress sdk.AccAddress
+	var fromName string
+	var err error
+	if baseReq.GenerateOnly {
+		fromAddress, err = sdk.AccAddressFromBech32(baseReq.From)
+	} else {
+		fromAddress, fromName, err = context.GetFromFieldsFromAddr(baseReq.From)
+	}
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return cliCtx, false
+	}
+
+	return cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(baseReq.BroadcastMode), true
+}
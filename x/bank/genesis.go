@@ -1,32 +1,56 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/barkisnet/barkis/types"
 )
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabled           bool             `json:"send_enabled" yaml:"send_enabled"`
+	MemoRequiredAddresses []sdk.AccAddress `json:"memo_required_addresses" yaml:"memo_required_addresses"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(sendEnabled bool, memoRequiredAddrs []sdk.AccAddress) GenesisState {
+	return GenesisState{SendEnabled: sendEnabled, MemoRequiredAddresses: memoRequiredAddrs}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+func DefaultGenesisState() GenesisState { return NewGenesisState(true, nil) }
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	for _, addr := range data.MemoRequiredAddresses {
+		keeper.SetMemoRequired(ctx, addr, true)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	var memoRequiredAddrs []sdk.AccAddress
+	keeper.IterateMemoRequiredAddrs(ctx, func(addr sdk.AccAddress) bool {
+		memoRequiredAddrs = append(memoRequiredAddrs, addr)
+		return false
+	})
+
+	return NewGenesisState(keeper.GetSendEnabled(ctx), memoRequiredAddrs)
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool)
+	for _, addr := range data.MemoRequiredAddresses {
+		if addr.Empty() {
+			return fmt.Errorf("empty memo required address")
+		}
+		if seen[addr.String()] {
+			return fmt.Errorf("duplicate memo required address %s", addr)
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package bank
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/auth"
 	"github.com/barkisnet/barkis/x/bank/internal/keeper"
 	"github.com/barkisnet/barkis/x/bank/internal/types"
 )
@@ -20,6 +22,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgMultiSend:
 			return handleMsgMultiSend(ctx, k, msg)
 
+		case types.MsgSetMemoRequired:
+			return handleMsgSetMemoRequired(ctx, k, msg)
+
+		case types.MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, k, msg)
+
+		case types.MsgCreatePeriodicVestingAccount:
+			return handleMsgCreatePeriodicVestingAccount(ctx, k, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized bank message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -79,3 +90,88 @@ func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Handle MsgSetMemoRequired.
+func handleMsgSetMemoRequired(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetMemoRequired) sdk.Result {
+	k.SetMemoRequired(ctx, msg.Address, msg.Required)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetMemoRequired,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyMemoRequired, strconv.FormatBool(msg.Required)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgCreateVestingAccount.
+func handleMsgCreateVestingAccount(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateVestingAccount) sdk.Result {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	startTime := ctx.BlockHeader().Time.Unix()
+	if msg.EndTime <= startTime {
+		return types.ErrInvalidVestingSchedule(k.Codespace(), "end time must be after the current block time").Result()
+	}
+
+	baseAcc := auth.NewBaseAccount(msg.ToAddress, msg.Amount, nil, 0, 0)
+
+	var vacc auth.VestingAccount
+	if msg.Delayed {
+		vacc = auth.NewDelayedVestingAccount(baseAcc, msg.EndTime)
+	} else {
+		vacc = auth.NewContinuousVestingAccount(baseAcc, startTime, msg.EndTime)
+	}
+
+	if err := k.CreateVestingAccount(ctx, msg.FromAddress, vacc); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgCreatePeriodicVestingAccount.
+func handleMsgCreatePeriodicVestingAccount(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreatePeriodicVestingAccount) sdk.Result {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	baseAcc := auth.NewBaseAccount(msg.ToAddress, msg.VestingPeriods.TotalAmount(), nil, 0, 0)
+	vacc := auth.NewPeriodicVestingAccount(baseAcc, msg.StartTime, msg.VestingPeriods)
+
+	if err := k.CreateVestingAccount(ctx, msg.FromAddress, vacc); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -18,6 +18,7 @@ import (
 type testInput struct {
 	cdc *codec.Codec
 	ctx sdk.Context
+	key *sdk.KVStoreKey
 	k   Keeper
 	ak  auth.AccountKeeper
 	pk  params.Keeper
@@ -31,11 +32,13 @@ func setupTestInput() testInput {
 	codec.RegisterCrypto(cdc)
 
 	authCapKey := sdk.NewKVStoreKey("authCapKey")
+	bankKey := sdk.NewKVStoreKey(types.StoreKey)
 	keyParams := sdk.NewKVStoreKey("params")
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.LoadLatestVersion()
@@ -52,8 +55,8 @@ func setupTestInput() testInput {
 
 	ak.SetParams(ctx, auth.DefaultParams())
 
-	bankKeeper := NewBaseKeeper(ak, pk.Subspace(types.DefaultParamspace), types.DefaultCodespace, blacklistedAddrs)
+	bankKeeper := NewBaseKeeper(bankKey, ak, pk.Subspace(types.DefaultParamspace), types.DefaultCodespace, blacklistedAddrs)
 	bankKeeper.SetSendEnabled(ctx, true)
 
-	return testInput{cdc: cdc, ctx: ctx, k: bankKeeper, ak: ak, pk: pk}
+	return testInput{cdc: cdc, ctx: ctx, key: bankKey, k: bankKeeper, ak: ak, pk: pk}
 }
@@ -21,6 +21,9 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	TransferDelegatedCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+
+	CreateVestingAccount(ctx sdk.Context, fromAddr sdk.AccAddress, vacc exported.VestingAccount) sdk.Error
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
@@ -32,13 +35,13 @@ type BaseKeeper struct {
 }
 
 // NewBaseKeeper returns a new BaseKeeper
-func NewBaseKeeper(ak types.AccountKeeper,
+func NewBaseKeeper(key sdk.StoreKey, ak types.AccountKeeper,
 	paramSpace params.Subspace,
 	codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(types.ParamKeyTable())
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(ak, ps, codespace, blacklistedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(key, ak, ps, codespace, blacklistedAddrs),
 		ak:             ak,
 		paramSpace:     ps,
 	}
@@ -131,6 +134,102 @@ func (keeper BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegat
 	return nil
 }
 
+// TransferDelegatedCoins tracks the transfer of amt delegated coins from the
+// account with address fromAddr to the account with address toAddr. The coins
+// stay bonded, so the base coins of both accounts are left untouched; only the
+// delegated vesting and delegated free amounts of vesting accounts are updated.
+// An error is returned if the transfer would release coins which are still
+// vesting.
+func (keeper BaseKeeper) TransferDelegatedCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+
+	if !amt.IsValid() {
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+
+	// a delegator may not have an account, e.g. when it received a transfer
+	// without holding any coins, in which case there is nothing to track
+	fromAcc := keeper.ak.GetAccount(ctx, fromAddr)
+	if vacc, ok := fromAcc.(exported.VestingAccount); ok {
+		coins := vacc.GetCoins()
+		vacc.TrackUndelegation(amt)
+		if err := vacc.SetCoins(coins); err != nil {
+			return sdk.ErrInternal(fmt.Sprintf("failed to track delegation transfer: %v", err))
+		}
+
+		// the coins still vesting must remain covered by the base coins and
+		// the delegated vesting coins of the account
+		vestingCoins := vacc.GetVestingCoins(ctx.BlockHeader().Time)
+		for _, coin := range amt {
+			covered := coins.AmountOf(coin.Denom).Add(vacc.GetDelegatedVesting().AmountOf(coin.Denom))
+			if covered.LT(vestingCoins.AmountOf(coin.Denom)) {
+				return sdk.ErrInsufficientCoins(
+					fmt.Sprintf("delegated vesting coins of %s can't be transferred", fromAddr),
+				)
+			}
+		}
+
+		keeper.ak.SetAccount(ctx, vacc)
+	}
+
+	toAcc := keeper.ak.GetAccount(ctx, toAddr)
+	if vacc, ok := toAcc.(exported.VestingAccount); ok {
+		vacc.TrackDelegationTransfer(amt)
+		keeper.ak.SetAccount(ctx, vacc)
+	}
+
+	return nil
+}
+
+// CreateVestingAccount funds a new vesting account with its original vesting
+// coins, which are deducted from the account with address fromAddr. The
+// vesting account must not exist yet; an account number is assigned to it.
+func (keeper BaseKeeper) CreateVestingAccount(ctx sdk.Context, fromAddr sdk.AccAddress, vacc exported.VestingAccount) sdk.Error {
+	toAddr := vacc.GetAddress()
+	if keeper.ak.GetAccount(ctx, toAddr) != nil {
+		return types.ErrAccountExists(keeper.Codespace(), toAddr)
+	}
+
+	amt := vacc.GetOriginalVesting()
+	if !amt.IsValid() || !amt.IsAllPositive() {
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+	if vacc.GetStartTime() >= vacc.GetEndTime() {
+		return types.ErrInvalidVestingSchedule(keeper.Codespace(), "start time must be before end time")
+	}
+
+	// set the coins before any state is written, so that an error leaves the
+	// sender untouched
+	if err := vacc.SetCoins(amt); err != nil {
+		return sdk.ErrInvalidCoins(err.Error())
+	}
+
+	_, err := keeper.SubtractCoins(ctx, fromAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	acc := keeper.ak.NewAccountWithAddress(ctx, toAddr)
+	if err := vacc.SetAccountNumber(acc.GetAccountNumber()); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+
+	keeper.ak.SetAccount(ctx, vacc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+		),
+	})
+
+	return nil
+}
+
 // SendKeeper defines a module interface that facilitates the transfer of coins
 // between accounts without the possibility of creating coins.
 type SendKeeper interface {
@@ -147,6 +246,10 @@ type SendKeeper interface {
 	SetSendEnabled(ctx sdk.Context, enabled bool)
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
+
+	GetMemoRequired(ctx sdk.Context, addr sdk.AccAddress) bool
+	SetMemoRequired(ctx sdk.Context, addr sdk.AccAddress, required bool)
+	IterateMemoRequiredAddrs(ctx sdk.Context, process func(sdk.AccAddress) bool)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -156,6 +259,7 @@ var _ SendKeeper = (*BaseSendKeeper)(nil)
 type BaseSendKeeper struct {
 	BaseViewKeeper
 
+	storeKey   sdk.StoreKey
 	ak         types.AccountKeeper
 	paramSpace params.Subspace
 
@@ -164,11 +268,12 @@ type BaseSendKeeper struct {
 }
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
-func NewBaseSendKeeper(ak types.AccountKeeper,
+func NewBaseSendKeeper(key sdk.StoreKey, ak types.AccountKeeper,
 	paramSpace params.Subspace, codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseSendKeeper {
 
 	return BaseSendKeeper{
 		BaseViewKeeper:   NewBaseViewKeeper(ak, codespace),
+		storeKey:         key,
 		ak:               ak,
 		paramSpace:       paramSpace,
 		blacklistedAddrs: blacklistedAddrs,
@@ -334,6 +439,38 @@ func (keeper BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
 	return keeper.blacklistedAddrs[addr.String()]
 }
 
+// GetMemoRequired returns whether the given address only accepts transactions
+// with a non-empty memo
+func (keeper BaseSendKeeper) GetMemoRequired(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(types.GetMemoRequiredKey(addr))
+}
+
+// SetMemoRequired sets the memo required flag of the given address
+func (keeper BaseSendKeeper) SetMemoRequired(ctx sdk.Context, addr sdk.AccAddress, required bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	if required {
+		store.Set(types.GetMemoRequiredKey(addr), []byte{0x01})
+		return
+	}
+	store.Delete(types.GetMemoRequiredKey(addr))
+}
+
+// IterateMemoRequiredAddrs iterates over all the addresses flagged as memo
+// required and performs a callback function
+func (keeper BaseSendKeeper) IterateMemoRequiredAddrs(ctx sdk.Context, process func(sdk.AccAddress) bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.MemoRequiredKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Key()[len(types.MemoRequiredKeyPrefix):])
+		if process(addr) {
+			return
+		}
+	}
+}
+
 var _ ViewKeeper = (*BaseViewKeeper)(nil)
 
 // ViewKeeper defines a module interface that facilitates read only access to
@@ -100,7 +100,7 @@ func TestSendKeeper(t *testing.T) {
 	blacklistedAddrs := make(map[string]bool)
 
 	paramSpace := input.pk.Subspace("newspace")
-	sendKeeper := NewBaseSendKeeper(input.ak, paramSpace, types.DefaultCodespace, blacklistedAddrs)
+	sendKeeper := NewBaseSendKeeper(input.key, input.ak, paramSpace, types.DefaultCodespace, blacklistedAddrs)
 	input.k.SetSendEnabled(ctx, true)
 
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -144,6 +144,31 @@ func TestSendKeeper(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestMemoRequired(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	require.False(t, input.k.GetMemoRequired(ctx, addr))
+
+	input.k.SetMemoRequired(ctx, addr, true)
+	input.k.SetMemoRequired(ctx, addr2, true)
+	require.True(t, input.k.GetMemoRequired(ctx, addr))
+	require.True(t, input.k.GetMemoRequired(ctx, addr2))
+
+	input.k.SetMemoRequired(ctx, addr2, false)
+	require.False(t, input.k.GetMemoRequired(ctx, addr2))
+
+	var addrs []sdk.AccAddress
+	input.k.IterateMemoRequiredAddrs(ctx, func(a sdk.AccAddress) bool {
+		addrs = append(addrs, a)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{addr}, addrs)
+}
+
 func TestViewKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
@@ -231,6 +256,58 @@ func TestVestingAccountReceive(t *testing.T) {
 	require.Equal(t, vacc.SpendableCoins(now.Add(12*time.Hour)), origCoins)
 }
 
+func TestCreateVestingAccount(t *testing.T) {
+	input := setupTestInput()
+	now := tmtime.Now()
+	ctx := input.ctx.WithBlockHeader(abci.Header{Time: now})
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 60))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	acc := input.ak.NewAccountWithAddress(ctx, addr1)
+	acc.SetCoins(origCoins)
+	input.ak.SetAccount(ctx, acc)
+
+	periods := auth.Periods{
+		auth.Period{Length: int64(12 * 60 * 60), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+		auth.Period{Length: int64(12 * 60 * 60), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+	}
+	bacc := auth.NewBaseAccount(addr2, vestingCoins, nil, 0, 0)
+	vacc := auth.NewPeriodicVestingAccount(bacc, now.Unix(), periods)
+
+	// require the vesting coins to be deducted from the sender
+	require.NoError(t, input.k.CreateVestingAccount(ctx, addr1, vacc))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), input.k.GetCoins(ctx, addr1))
+
+	// require the new account to be a funded vesting account with a fresh account number
+	pva, ok := input.ak.GetAccount(ctx, addr2).(*auth.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, vestingCoins, pva.GetCoins())
+	require.Equal(t, vestingCoins, pva.GetOriginalVesting())
+	require.Equal(t, now.Add(24*time.Hour).Unix(), pva.GetEndTime())
+	require.NotEqual(t, acc.GetAccountNumber(), pva.GetAccountNumber())
+
+	// require that an existing account cannot be replaced
+	vacc2 := auth.NewDelayedVestingAccount(auth.NewBaseAccount(addr2, vestingCoins, nil, 0, 0), now.Unix())
+	require.Error(t, input.k.CreateVestingAccount(ctx, addr1, vacc2))
+
+	// require the sender to have enough coins
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	vacc3 := auth.NewDelayedVestingAccount(auth.NewBaseAccount(addr3, vestingCoins, nil, 0, 0), now.Unix())
+	require.Error(t, input.k.CreateVestingAccount(ctx, addr1, vacc3))
+	require.Nil(t, input.ak.GetAccount(ctx, addr3))
+
+	// require an invalid vesting account to be rejected without changing the sender
+	vacc4 := auth.NewContinuousVestingAccount(auth.NewBaseAccount(addr3, sdk.Coins{}, nil, 0, 0), now.Unix(), now.Unix()+1)
+	require.Error(t, input.k.CreateVestingAccount(ctx, addr1, vacc4))
+	vacc5 := auth.NewContinuousVestingAccount(auth.NewBaseAccount(addr3, vestingCoins, nil, 0, 0), now.Unix(), now.Unix())
+	require.Error(t, input.k.CreateVestingAccount(ctx, addr1, vacc5))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), input.k.GetCoins(ctx, addr1))
+	require.Nil(t, input.ak.GetAccount(ctx, addr3))
+}
+
 func TestDelegateCoins(t *testing.T) {
 	input := setupTestInput()
 	now := tmtime.Now()
@@ -333,6 +410,64 @@ func TestUndelegateCoins(t *testing.T) {
 	require.True(t, macc.GetCoins().Empty())
 }
 
+func TestTransferDelegatedCoins(t *testing.T) {
+	input := setupTestInput()
+	now := tmtime.Now()
+	ctx := input.ctx.WithBlockHeader(abci.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
+
+	bacc1 := auth.NewBaseAccountWithAddress(addr1)
+	bacc1.SetCoins(origCoins)
+	vacc1 := auth.NewContinuousVestingAccount(&bacc1, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+	bacc3 := auth.NewBaseAccountWithAddress(addr3)
+	bacc3.SetCoins(origCoins)
+	vacc3 := auth.NewContinuousVestingAccount(&bacc3, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+	macc := input.ak.NewAccountWithAddress(ctx, addrModule)
+	input.ak.SetAccount(ctx, vacc1)
+	input.ak.SetAccount(ctx, vacc3)
+	input.ak.SetAccount(ctx, macc)
+
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+
+	// delegate all the coins of the vesting account, half of which are still vesting
+	err := input.k.DelegateCoins(ctx, addr1, addrModule, origCoins)
+	require.NoError(t, err)
+
+	// the coins still vesting can't be transferred
+	err = input.k.TransferDelegatedCoins(ctx, addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))
+	require.Error(t, err)
+
+	// the vested coins can be transferred, the base coins are left untouched
+	err = input.k.TransferDelegatedCoins(ctx, addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)))
+	require.NoError(t, err)
+
+	vacc1 = input.ak.GetAccount(ctx, addr1).(*auth.ContinuousVestingAccount)
+	require.True(t, vacc1.GetCoins().Empty())
+	require.True(t, vacc1.GetDelegatedFree().Empty())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), vacc1.GetDelegatedVesting())
+	require.True(t, input.k.GetCoins(ctx, addr2).Empty())
+
+	// a vesting recipient tracks the received delegation as not vesting
+	err = input.k.TransferDelegatedCoins(ctx, addr2, addr3, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, err)
+
+	vacc3 = input.ak.GetAccount(ctx, addr3).(*auth.ContinuousVestingAccount)
+	require.Equal(t, origCoins, vacc3.GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), vacc3.GetDelegatedFree())
+	require.True(t, vacc3.GetDelegatedVesting().Empty())
+
+	// the received delegation does not lock any of the vesting coins
+	vestingCoins := vacc3.GetVestingCoins(ctx.BlockHeader().Time)
+	require.Equal(t, origCoins.Sub(vestingCoins), vacc3.SpendableCoins(ctx.BlockHeader().Time))
+}
+
 func TestMsgMultiSendEvents(t *testing.T) {
 	app := setupTestInput()
 
@@ -13,6 +13,9 @@ import (
 const (
 	// query balance path
 	QueryBalance = "balances"
+
+	// query memo required flag path
+	QueryMemoRequired = "memo_required"
 )
 
 // NewQuerier returns a new sdk.Keeper instance.
@@ -22,6 +25,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryBalance:
 			return queryBalance(ctx, req, k)
 
+		case QueryMemoRequired:
+			return queryMemoRequired(ctx, req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown bank query endpoint")
 		}
@@ -44,3 +50,26 @@ func queryBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk
 
 	return bz, nil
 }
+
+// queryMemoRequired fetch the memo required flag of an account.
+func queryMemoRequired(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if !sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.MemoRequiredUpgrade) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("memo required query is not supported until %d",
+			sdk.GlobalUpgradeMgr.GetUpgradeHeight(sdk.MemoRequiredUpgrade)))
+	}
+
+	var params types.QueryMemoRequiredParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(
+		types.ModuleCdc, types.NewMemoRequired(params.Address, k.GetMemoRequired(ctx, params.Address)),
+	)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
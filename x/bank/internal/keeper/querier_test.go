@@ -46,6 +46,49 @@ func TestBalances(t *testing.T) {
 	require.True(t, coins.AmountOf("foo").Equal(sdk.NewInt(10)))
 }
 
+func TestQueryMemoRequired(t *testing.T) {
+	input := setupTestInput()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", QueryMemoRequired),
+		Data: []byte{},
+	}
+
+	querier := NewQuerier(input.k)
+
+	// the query is not supported before the upgrade
+	_, _, addr := authtypes.KeyTestPubAddr()
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryMemoRequiredParams(addr))
+	res, err := querier(input.ctx, []string{QueryMemoRequired}, req)
+	require.NotNil(t, err)
+	require.Nil(t, res)
+
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.MemoRequiredUpgrade, 1)
+	sdk.GlobalUpgradeMgr.SetBlockHeight(1)
+	defer func() {
+		delete(sdk.GlobalUpgradeMgr.Config.UpgradeHeight, sdk.MemoRequiredUpgrade)
+		sdk.GlobalUpgradeMgr.SetBlockHeight(0)
+	}()
+
+	req.Data = []byte{}
+	res, err = querier(input.ctx, []string{QueryMemoRequired}, req)
+	require.NotNil(t, err)
+	require.Nil(t, res)
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryMemoRequiredParams(addr))
+	res, err = querier(input.ctx, []string{QueryMemoRequired}, req)
+	require.Nil(t, err)
+
+	var memoRequired types.MemoRequired
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &memoRequired))
+	require.Equal(t, types.NewMemoRequired(addr, false), memoRequired)
+
+	input.k.SetMemoRequired(input.ctx, addr, true)
+	res, err = querier(input.ctx, []string{QueryMemoRequired}, req)
+	require.Nil(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &memoRequired))
+	require.Equal(t, types.NewMemoRequired(addr, true), memoRequired)
+}
+
 func TestQuerierRouteNotFound(t *testing.T) {
 	input := setupTestInput()
 	req := abci.RequestQuery{
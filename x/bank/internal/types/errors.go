@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/barkisnet/barkis/types"
 )
 
@@ -10,6 +12,9 @@ const (
 
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeMemoRequired         sdk.CodeType = 103
+	CodeInvalidVesting       sdk.CodeType = 104
+	CodeAccountExists        sdk.CodeType = 105
 )
 
 // ErrNoInputs is an error
@@ -31,3 +36,18 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrMemoRequired is an error
+func ErrMemoRequired(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeMemoRequired, fmt.Sprintf("%s requires a non-empty memo to receive transactions", addr))
+}
+
+// ErrInvalidVestingSchedule is an error
+func ErrInvalidVestingSchedule(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVesting, fmt.Sprintf("invalid vesting schedule: %s", msg))
+}
+
+// ErrAccountExists is an error
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountExists, fmt.Sprintf("account %s already exists", addr))
+}
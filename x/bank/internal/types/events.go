@@ -2,10 +2,13 @@ package types
 
 // Bank module event types
 var (
-	EventTypeTransfer = "transfer"
+	EventTypeTransfer        = "transfer"
+	EventTypeSetMemoRequired = "set_memo_required"
 
-	AttributeKeyRecipient = "recipient"
-	AttributeKeySender    = "sender"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeySender       = "sender"
+	AttributeKeyAddress      = "address"
+	AttributeKeyMemoRequired = "memo_required"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,7 +1,23 @@
 package types
 
+import (
+	sdk "github.com/barkisnet/barkis/types"
+)
+
 const (
 	// module name
-	ModuleName   = "bank"
+	ModuleName = "bank"
+
+	// StoreKey is the store key string for bank
+	StoreKey     = ModuleName
 	QuerierRoute = ModuleName
 )
+
+var (
+	MemoRequiredKeyPrefix = []byte{0x01}
+)
+
+// GetMemoRequiredKey returns the store key of the memo required flag of an account
+func GetMemoRequiredKey(addr sdk.AccAddress) []byte {
+	return append(MemoRequiredKeyPrefix, addr.Bytes()...)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/auth"
 )
 
 // RouterKey is they name of the bank module
@@ -101,6 +102,164 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// MsgSetMemoRequired - flags an account so that it only receives coins from
+// transactions carrying a non-empty memo
+type MsgSetMemoRequired struct {
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Required bool           `json:"required" yaml:"required"`
+}
+
+var _ sdk.Msg = MsgSetMemoRequired{}
+
+// NewMsgSetMemoRequired - construct a msg to set the memo required flag of an account.
+func NewMsgSetMemoRequired(addr sdk.AccAddress, required bool) MsgSetMemoRequired {
+	return MsgSetMemoRequired{Address: addr, Required: required}
+}
+
+// Route Implements Msg
+func (msg MsgSetMemoRequired) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetMemoRequired) Type() string { return "set_memo_required" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetMemoRequired) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing account address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetMemoRequired) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetMemoRequired) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// MsgCreateVestingAccount - creates a new continuous or delayed vesting account
+// funded with coins from the sender. Vesting starts at the block time.
+type MsgCreateVestingAccount struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	EndTime     int64          `json:"end_time" yaml:"end_time"`
+	Delayed     bool           `json:"delayed" yaml:"delayed"`
+}
+
+var _ sdk.Msg = MsgCreateVestingAccount{}
+
+// NewMsgCreateVestingAccount - construct a msg to create a vesting account.
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins,
+	endTime int64, delayed bool) MsgCreateVestingAccount {
+
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCreateVestingAccount) Type() string { return "create_vesting_account" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("vesting amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("vesting amount must be positive")
+	}
+	if msg.EndTime <= 0 {
+		return ErrInvalidVestingSchedule(DefaultCodespace, "end time must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCreatePeriodicVestingAccount - creates a new periodic vesting account
+// funded with coins from the sender. The vesting amount is the sum of all periods.
+type MsgCreatePeriodicVestingAccount struct {
+	FromAddress    sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress      sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	StartTime      int64          `json:"start_time" yaml:"start_time"`
+	VestingPeriods auth.Periods   `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+var _ sdk.Msg = MsgCreatePeriodicVestingAccount{}
+
+// NewMsgCreatePeriodicVestingAccount - construct a msg to create a periodic vesting account.
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64,
+	periods auth.Periods) MsgCreatePeriodicVestingAccount {
+
+	return MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCreatePeriodicVestingAccount) Type() string { return "create_periodic_vesting_account" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if msg.StartTime <= 0 {
+		return ErrInvalidVestingSchedule(DefaultCodespace, "start time must be positive")
+	}
+	if err := msg.VestingPeriods.Validate(); err != nil {
+		return ErrInvalidVestingSchedule(DefaultCodespace, err.Error())
+	}
+	if _, err := msg.VestingPeriods.EndTime(msg.StartTime); err != nil {
+		return ErrInvalidVestingSchedule(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
 // Input models transaction input
 type Input struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
@@ -2,11 +2,13 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/auth"
 )
 
 func TestMsgSendRoute(t *testing.T) {
@@ -241,6 +243,97 @@ func TestMsgMultiSendGetSigners(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%v", res), "[696E70757431 696E70757432 696E70757433]")
 }
 
+func TestMsgSetMemoRequiredValidation(t *testing.T) {
+	addr := sdk.AccAddress([]byte("from"))
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		tx    MsgSetMemoRequired
+	}{
+		{true, NewMsgSetMemoRequired(addr, true)},
+		{true, NewMsgSetMemoRequired(addr, false)},
+		{false, NewMsgSetMemoRequired(emptyAddr, true)},
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgSetMemoRequiredGetSignBytes(t *testing.T) {
+	var msg = NewMsgSetMemoRequired(sdk.AccAddress([]byte("input")), true)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgSetMemoRequired","value":{"address":"barkis1d9h8qat5kqprja","required":true}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgCreateVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.Coins{sdk.NewInt64Coin("atom", 0)}
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		tx    MsgCreateVestingAccount
+	}{
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 1554668078, false)},
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 1554668078, true)},
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom0, 1554668078, false)},       // zero amount
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, false)},              // missing end time
+		{false, NewMsgCreateVestingAccount(emptyAddr, addr2, atom123, 1554668078, false)}, // empty from addr
+		{false, NewMsgCreateVestingAccount(addr1, emptyAddr, atom123, 1554668078, false)}, // empty to addr
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgCreatePeriodicVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	var emptyAddr sdk.AccAddress
+
+	periods := auth.Periods{{Length: 7776000, Amount: atom123}, {Length: 7776000, Amount: atom123}}
+
+	cases := []struct {
+		valid bool
+		tx    MsgCreatePeriodicVestingAccount
+	}{
+		{true, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1554668078, periods)},
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 0, periods)},                                  // missing start time
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1554668078, nil)},                             // no periods
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1554668078, auth.Periods{{Amount: atom123}})}, // zero length period
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, math.MaxInt64, periods)},                      // end time overflow
+		{false, NewMsgCreatePeriodicVestingAccount(emptyAddr, addr2, 1554668078, periods)},                     // empty from addr
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, emptyAddr, 1554668078, periods)},                     // empty to addr
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
 /*
 // what to do w/ this test?
 func TestMsgSendSigners(t *testing.T) {
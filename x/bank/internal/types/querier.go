@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/barkisnet/barkis/types"
 )
 
@@ -13,3 +15,32 @@ type QueryBalanceParams struct {
 func NewQueryBalanceParams(addr sdk.AccAddress) QueryBalanceParams {
 	return QueryBalanceParams{Address: addr}
 }
+
+// QueryMemoRequiredParams defines the params for querying the memo required
+// flag of an account.
+type QueryMemoRequiredParams struct {
+	Address sdk.AccAddress
+}
+
+// NewQueryMemoRequiredParams creates a new instance of QueryMemoRequiredParams.
+func NewQueryMemoRequiredParams(addr sdk.AccAddress) QueryMemoRequiredParams {
+	return QueryMemoRequiredParams{Address: addr}
+}
+
+// MemoRequired defines the memo required flag of an account returned by the
+// memo required query.
+type MemoRequired struct {
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Required bool           `json:"required" yaml:"required"`
+}
+
+// NewMemoRequired creates a new instance of MemoRequired.
+func NewMemoRequired(addr sdk.AccAddress, required bool) MemoRequired {
+	return MemoRequired{Address: addr, Required: required}
+}
+
+func (mr MemoRequired) String() string {
+	return fmt.Sprintf(`Memo Required:
+  Address:  %s
+  Required: %t`, mr.Address, mr.Required)
+}
@@ -14,28 +14,43 @@ const (
 	CodeInvalidInput  = types.CodeInvalidInput
 	ModuleName        = types.ModuleName
 	DefaultParamspace = types.DefaultParamspace
+	QuerierRoute      = types.QuerierRoute
+	QueryInvariants   = types.QueryInvariants
+	PolicyHalt        = types.PolicyHalt
+	PolicyEvent       = types.PolicyEvent
+	PolicyDisable     = types.PolicyDisable
 )
 
 var (
 	// functions aliases
-	RegisterCodec         = types.RegisterCodec
-	ErrNilSender          = types.ErrNilSender
-	ErrUnknownInvariant   = types.ErrUnknownInvariant
-	NewGenesisState       = types.NewGenesisState
-	DefaultGenesisState   = types.DefaultGenesisState
-	NewMsgVerifyInvariant = types.NewMsgVerifyInvariant
-	ParamKeyTable         = types.ParamKeyTable
-	NewInvarRoute         = types.NewInvarRoute
-	NewKeeper             = keeper.NewKeeper
+	RegisterCodec             = types.RegisterCodec
+	ErrNilSender              = types.ErrNilSender
+	ErrUnknownInvariant       = types.ErrUnknownInvariant
+	ErrInvariantDisabled      = types.ErrInvariantDisabled
+	NewGenesisState           = types.NewGenesisState
+	DefaultGenesisState       = types.DefaultGenesisState
+	NewMsgVerifyInvariant     = types.NewMsgVerifyInvariant
+	ParamKeyTable             = types.ParamKeyTable
+	NewInvarRoute             = types.NewInvarRoute
+	NewInvariantPolicy        = types.NewInvariantPolicy
+	ValidInvariantPolicy      = types.ValidInvariantPolicy
+	ValidateInvariantPolicies = types.ValidateInvariantPolicies
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeyConstantFee = types.ParamStoreKeyConstantFee
+	ModuleCdc                      = types.ModuleCdc
+	ParamStoreKeyConstantFee       = types.ParamStoreKeyConstantFee
+	ParamStoreKeyInvariantPolicies = types.ParamStoreKeyInvariantPolicies
 )
 
 type (
 	GenesisState       = types.GenesisState
 	MsgVerifyInvariant = types.MsgVerifyInvariant
 	InvarRoute         = types.InvarRoute
+	InvariantPolicy    = types.InvariantPolicy
+	InvariantCheck     = types.InvariantCheck
+	InvariantStatus    = types.InvariantStatus
+	InvariantStatuses  = types.InvariantStatuses
 	Keeper             = keeper.Keeper
 )
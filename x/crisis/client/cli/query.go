@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/barkisnet/barkis/client"
+	"github.com/barkisnet/barkis/client/context"
+	"github.com/barkisnet/barkis/codec"
+	"github.com/barkisnet/barkis/x/crisis/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group crisis queries under a subcommand
+	crisisQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	crisisQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryInvariants(cdc),
+	)...)
+
+	return crisisQueryCmd
+}
+
+// GetCmdQueryInvariants implements the query invariants command.
+func GetCmdQueryInvariants(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "invariants",
+		Args:  cobra.NoArgs,
+		Short: "Query the registered invariants with their policy and last check result",
+		Long: strings.TrimSpace(`Query the registered invariants with their policy and the result of their
+last check. The checks depend on the invariant check period of the queried node.
+
+$ <appcli> query crisis invariants
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariants)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var statuses types.InvariantStatuses
+			cdc.MustUnmarshalJSON(res, &statuses)
+			return cliCtx.PrintOutput(statuses)
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/barkisnet/barkis/client/context"
+	"github.com/barkisnet/barkis/types/rest"
+	"github.com/barkisnet/barkis/x/crisis/internal/types"
+)
+
+// RegisterRoutes registers crisis-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// Query the registered invariants
+	r.HandleFunc(
+		"/crisis/invariants",
+		invariantsHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+// HTTP request handler to query the registered invariants
+func invariantsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariants)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -9,10 +9,15 @@ import (
 // new crisis genesis
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
 	keeper.SetConstantFee(ctx, data.ConstantFee)
+	if data.InvariantPolicies != nil {
+		keeper.SetInvariantPolicies(ctx, data.InvariantPolicies)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	constantFee := keeper.GetConstantFee(ctx)
-	return types.NewGenesisState(constantFee)
+	genesisState := types.NewGenesisState(constantFee)
+	genesisState.InvariantPolicies = keeper.GetInvariantPolicies(ctx)
+	return genesisState
 }
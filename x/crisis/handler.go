@@ -27,6 +27,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k keeper.Keeper) sdk.Result {
+	msgFullRoute := msg.FullInvariantRoute()
+
+	// a disabled invariant is not checked, before the constant fee is charged
+	policy := k.GetInvariantPolicy(ctx, msgFullRoute)
+	if policy == types.PolicyDisable {
+		return types.ErrInvariantDisabled(types.DefaultCodespace).Result()
+	}
+
 	// remove the constant fee
 	constantFee := sdk.NewCoins(k.GetConstantFee(ctx))
 
@@ -38,13 +46,12 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 	cacheCtx, _ := ctx.CacheContext()
 
 	found := false
-	msgFullRoute := msg.FullInvariantRoute()
 
 	var res string
 	var stop bool
 	for _, invarRoute := range k.Routes() {
 		if invarRoute.FullRoute() == msgFullRoute {
-			res, stop = invarRoute.Invar(cacheCtx)
+			res, stop = k.CheckInvariant(cacheCtx, invarRoute, policy)
 			found = true
 			break
 		}
@@ -54,7 +61,13 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 		return types.ErrUnknownInvariant(types.DefaultCodespace).Result()
 	}
 
-	if stop {
+	// only the invariants with the halt policy stop the chain, the other ones
+	// are reported with an invariant-broken event
+	if stop && policy != types.PolicyHalt {
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	if stop && policy == types.PolicyHalt {
 		// NOTE currently, because the chain halts here, this transaction will never be included
 		// in the blockchain thus the constant fee will have never been deducted. Thus no
 		// refund is required.
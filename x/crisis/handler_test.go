@@ -79,6 +79,47 @@ func TestHandleMsgVerifyInvariantWithInvariantBroken(t *testing.T) {
 	}, fmt.Sprintf("%v", res))
 }
 
+func TestHandleMsgVerifyInvariantWithInvariantBrokenAndEventPolicy(t *testing.T) {
+	ctx, crisisKeeper, _, _ := CreateTestInput(t)
+	sender := addrs[0]
+
+	crisisKeeper.SetInvariantPolicies(ctx, []crisis.InvariantPolicy{
+		crisis.NewInvariantPolicy(testModuleName+"/"+dummyRouteWhichFails.Route, crisis.PolicyEvent),
+	})
+
+	h := crisis.NewHandler(crisisKeeper)
+	msg := crisis.NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichFails.Route)
+	res := h(ctx, msg)
+	require.True(t, res.IsOK())
+
+	reported := false
+	for _, event := range res.Events {
+		reported = reported || event.Type == "invariant-broken"
+	}
+	require.True(t, reported)
+}
+
+func TestHandleMsgVerifyInvariantWithDisablePolicy(t *testing.T) {
+	ctx, crisisKeeper, accKeeper, _ := CreateTestInput(t)
+	sender := addrs[0]
+	coins := accKeeper.GetAccount(ctx, sender).GetCoins()
+
+	crisisKeeper.SetInvariantPolicies(ctx, []crisis.InvariantPolicy{
+		crisis.NewInvariantPolicy(testModuleName+"/"+dummyRouteWhichFails.Route, crisis.PolicyDisable),
+	})
+
+	// the disabled invariant is neither checked nor charged for
+	h := crisis.NewHandler(crisisKeeper)
+	msg := crisis.NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichFails.Route)
+	var res sdk.Result
+	require.NotPanics(t, func() {
+		res = h(ctx, msg)
+	})
+	require.False(t, res.IsOK())
+	require.Equal(t, crisis.CodeInvalidInput, res.Code)
+	require.Equal(t, coins, accKeeper.GetAccount(ctx, sender).GetCoins())
+}
+
 func TestHandleMsgVerifyInvariantWithInvariantBrokenAndNotEnoughPoolCoins(t *testing.T) {
 	ctx, crisisKeeper, _, distrKeeper := CreateTestInput(t)
 	sender := addrs[0]
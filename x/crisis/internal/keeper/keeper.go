@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -20,6 +21,16 @@ type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// results of the last invariant checks made by this node, which depend on
+	// its invariant check period, so they are not part of the consensus state
+	checks *invariantChecks
+}
+
+// invariantChecks holds the last check of each invariant, by full route
+type invariantChecks struct {
+	mtx    sync.RWMutex
+	checks map[string]types.InvariantCheck
 }
 
 // NewKeeper creates a new Keeper object
@@ -34,6 +45,7 @@ func NewKeeper(
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		checks:           &invariantChecks{checks: make(map[string]types.InvariantCheck)},
 	}
 }
 
@@ -62,8 +74,8 @@ func (k Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
-// AssertInvariants asserts all registered invariants. If any invariant fails,
-// the method panics.
+// AssertInvariants asserts all registered invariants, according to their
+// policy. If an invariant with the halt policy fails, the method panics.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
@@ -71,7 +83,12 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	invarRoutes := k.Routes()
 
 	for _, ir := range invarRoutes {
-		if res, stop := ir.Invar(ctx); stop {
+		policy := k.GetInvariantPolicy(ctx, ir.FullRoute())
+		if policy == types.PolicyDisable {
+			continue
+		}
+
+		if res, stop := k.CheckInvariant(ctx, ir, policy); stop && policy == types.PolicyHalt {
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
 			panic(fmt.Errorf("invariant broken: %s\n"+
@@ -86,6 +103,64 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// CheckInvariant runs an invariant and records the result, unless the check is
+// made while checking or simulating a tx. A broken invariant is logged and
+// reported with an invariant-broken event, the caller is in charge of halting
+// the chain according to the policy.
+func (k Keeper) CheckInvariant(ctx sdk.Context, ir types.InvarRoute, policy string) (res string, stop bool) {
+	res, stop = ir.Invar(ctx)
+	if !ctx.IsCheckTx() {
+		k.setInvariantCheck(ir.FullRoute(), types.InvariantCheck{Height: ctx.BlockHeight(), Broken: stop, Message: res})
+	}
+	if !stop {
+		return
+	}
+
+	k.Logger(ctx).Error(fmt.Sprintf("invariant broken: %s", res), "route", ir.FullRoute(), "policy", policy)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInvariantBroken,
+			sdk.NewAttribute(types.AttributeKeyRoute, ir.FullRoute()),
+			sdk.NewAttribute(types.AttributeKeyPolicy, policy),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+			sdk.NewAttribute(types.AttributeKeyMessage, res),
+		),
+	)
+	return
+}
+
+func (k Keeper) setInvariantCheck(route string, check types.InvariantCheck) {
+	k.checks.mtx.Lock()
+	defer k.checks.mtx.Unlock()
+	k.checks.checks[route] = check
+}
+
+// GetInvariantCheck returns the result of the last check of an invariant made
+// by this node
+func (k Keeper) GetInvariantCheck(route string) (check types.InvariantCheck, found bool) {
+	k.checks.mtx.RLock()
+	defer k.checks.mtx.RUnlock()
+	check, found = k.checks.checks[route]
+	return
+}
+
+// GetInvariantStatuses returns every registered invariant with its policy and
+// the result of its last check made by this node
+func (k Keeper) GetInvariantStatuses(ctx sdk.Context) types.InvariantStatuses {
+	statuses := make(types.InvariantStatuses, len(k.routes))
+	for i, ir := range k.routes {
+		statuses[i] = types.InvariantStatus{
+			ModuleName: ir.ModuleName,
+			Route:      ir.Route,
+			Policy:     k.GetInvariantPolicy(ctx, ir.FullRoute()),
+		}
+		if check, found := k.GetInvariantCheck(ir.FullRoute()); found {
+			statuses[i].LastCheck = &check
+		}
+	}
+	return statuses
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
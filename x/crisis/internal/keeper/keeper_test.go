@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/barkisnet/barkis/codec"
+	"github.com/barkisnet/barkis/store"
 	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/x/crisis/internal/types"
 	"github.com/barkisnet/barkis/x/params"
@@ -21,12 +24,25 @@ func testFailingInvariant(_ sdk.Context) (string, bool) {
 }
 
 func testKeeper(checkPeriod uint) Keeper {
+	_, k := testContextAndKeeper(checkPeriod)
+	return k
+}
+
+func testContextAndKeeper(checkPeriod uint) (sdk.Context, Keeper) {
 	cdc := codec.New()
-	paramsKeeper := params.NewKeeper(
-		cdc, sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey), params.DefaultCodespace,
-	)
+	keyParams, tkeyParams := sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
 
-	return NewKeeper(paramsKeeper.Subspace(types.DefaultParamspace), checkPeriod, nil, "test")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	return ctx, NewKeeper(paramsKeeper.Subspace(types.DefaultParamspace), checkPeriod, nil, "test")
 }
 
 func TestLogger(t *testing.T) {
@@ -45,8 +61,7 @@ func TestInvariants(t *testing.T) {
 }
 
 func TestAssertInvariants(t *testing.T) {
-	k := testKeeper(5)
-	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	ctx, k := testContextAndKeeper(5)
 
 	k.RegisterRoute("testModule", "testRoute1", testPassingInvariant)
 	require.NotPanics(t, func() { k.AssertInvariants(ctx) })
@@ -54,3 +69,72 @@ func TestAssertInvariants(t *testing.T) {
 	k.RegisterRoute("testModule", "testRoute2", testFailingInvariant)
 	require.Panics(t, func() { k.AssertInvariants(ctx) })
 }
+
+func TestAssertInvariantsPolicies(t *testing.T) {
+	ctx, k := testContextAndKeeper(5)
+	ctx = ctx.WithBlockHeight(10)
+
+	k.RegisterRoute("testModule", "testRoute1", testPassingInvariant)
+	k.RegisterRoute("testModule", "testRoute2", testFailingInvariant)
+	k.RegisterRoute("testModule", "testRoute3", testFailingInvariant)
+
+	k.SetInvariantPolicies(ctx, []types.InvariantPolicy{
+		types.NewInvariantPolicy("testModule/testRoute2", types.PolicyEvent),
+		types.NewInvariantPolicy("testModule/testRoute3", types.PolicyDisable),
+	})
+	require.NotPanics(t, func() { k.AssertInvariants(ctx) })
+
+	// only the invariant with the event policy is reported
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeInvariantBroken, events[0].Type)
+
+	statuses := k.GetInvariantStatuses(ctx)
+	require.Len(t, statuses, 3)
+	require.Equal(t, types.PolicyHalt, statuses[0].Policy)
+	require.Equal(t, &types.InvariantCheck{Height: 10}, statuses[0].LastCheck)
+	require.Equal(t, types.PolicyEvent, statuses[1].Policy)
+	require.True(t, statuses[1].LastCheck.Broken)
+	require.Equal(t, types.PolicyDisable, statuses[2].Policy)
+	require.Nil(t, statuses[2].LastCheck)
+
+	// without the event policy the broken invariant halts the chain again
+	k.SetInvariantPolicies(ctx, []types.InvariantPolicy{
+		types.NewInvariantPolicy("testModule/testRoute3", types.PolicyDisable),
+	})
+	require.Panics(t, func() { k.AssertInvariants(ctx) })
+}
+
+func TestCheckInvariantRecordedInDeliverTx(t *testing.T) {
+	ctx, k := testContextAndKeeper(5)
+	ctx = ctx.WithBlockHeight(10)
+
+	k.RegisterRoute("testModule", "testRoute", testFailingInvariant)
+	ir := k.Routes()[0]
+
+	// the checks made while checking or simulating a tx are not recorded
+	_, stop := k.CheckInvariant(ctx.WithIsCheckTx(true), ir, types.PolicyEvent)
+	require.True(t, stop)
+	_, found := k.GetInvariantCheck(ir.FullRoute())
+	require.False(t, found)
+
+	_, stop = k.CheckInvariant(ctx, ir, types.PolicyEvent)
+	require.True(t, stop)
+	check, found := k.GetInvariantCheck(ir.FullRoute())
+	require.True(t, found)
+	require.Equal(t, types.InvariantCheck{Height: 10, Broken: true}, check)
+}
+
+func TestUpdateInvariantPolicies(t *testing.T) {
+	ctx, k := testContextAndKeeper(5)
+
+	policies := []types.InvariantPolicy{types.NewInvariantPolicy("testModule/testRoute", types.PolicyEvent)}
+	bz := codec.New().MustMarshalJSON(policies)
+	require.NoError(t, k.paramSpace.Update(ctx, types.ParamStoreKeyInvariantPolicies, bz))
+	require.Equal(t, policies, k.GetInvariantPolicies(ctx))
+
+	// an unknown policy is rejected and leaves the policies unchanged
+	bz = codec.New().MustMarshalJSON([]types.InvariantPolicy{types.NewInvariantPolicy("testModule/testRoute", "ignore")})
+	require.Error(t, k.paramSpace.Update(ctx, types.ParamStoreKeyInvariantPolicies, bz))
+	require.Equal(t, policies, k.GetInvariantPolicies(ctx))
+}
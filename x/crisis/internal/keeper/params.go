@@ -15,3 +15,25 @@ func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
 	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
 }
+
+// GetInvariantPolicies get's the invariant policies from the paramSpace
+func (k Keeper) GetInvariantPolicies(ctx sdk.Context) (policies []types.InvariantPolicy) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyInvariantPolicies, &policies)
+	return
+}
+
+// SetInvariantPolicies set's the invariant policies in the paramSpace
+func (k Keeper) SetInvariantPolicies(ctx sdk.Context, policies []types.InvariantPolicy) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyInvariantPolicies, policies)
+}
+
+// GetInvariantPolicy returns the policy of an invariant, identified by its full
+// route, invariants without policy halt the chain when broken
+func (k Keeper) GetInvariantPolicy(ctx sdk.Context, route string) string {
+	for _, p := range k.GetInvariantPolicies(ctx) {
+		if p.Route == route {
+			return p.Policy
+		}
+	}
+	return types.PolicyHalt
+}
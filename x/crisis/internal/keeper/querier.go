@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/barkisnet/barkis/codec"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/crisis/internal/types"
+)
+
+// NewQuerier creates a querier for crisis REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+
+		case types.QueryInvariants:
+			return queryInvariants(ctx, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown crisis query endpoint")
+		}
+	}
+}
+
+func queryInvariants(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetInvariantStatuses(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
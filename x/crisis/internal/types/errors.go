@@ -21,3 +21,8 @@ func ErrNilSender(codespace sdk.CodespaceType) sdk.Error {
 func ErrUnknownInvariant(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "unknown invariant")
 }
+
+// ErrInvariantDisabled -  the invariant provided is disabled by its policy
+func ErrInvariantDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "invariant disabled")
+}
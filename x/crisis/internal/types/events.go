@@ -2,8 +2,12 @@ package types
 
 // Crisis module event types
 var (
-	EventTypeInvariant = "invariant"
+	EventTypeInvariant       = "invariant"
+	EventTypeInvariantBroken = "invariant-broken"
 
 	AttributeValueCrisis = ModuleName
 	AttributeKeyRoute    = "route"
+	AttributeKeyPolicy   = "policy"
+	AttributeKeyHeight   = "height"
+	AttributeKeyMessage  = "message"
 )
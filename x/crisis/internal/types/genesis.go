@@ -8,7 +8,8 @@ import (
 
 // GenesisState - crisis genesis state
 type GenesisState struct {
-	ConstantFee sdk.Coin `json:"constant_fee" yaml:"constant_fee"`
+	ConstantFee       sdk.Coin          `json:"constant_fee" yaml:"constant_fee"`
+	InvariantPolicies []InvariantPolicy `json:"invariant_policies,omitempty" yaml:"invariant_policies"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -30,5 +31,5 @@ func ValidateGenesis(data GenesisState) error {
 	if !data.ConstantFee.IsPositive() {
 		return fmt.Errorf("constant fee must be positive: %s", data.ConstantFee)
 	}
-	return nil
+	return ValidateInvariantPolicies(data.InvariantPolicies)
 }
@@ -3,4 +3,7 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// QuerierRoute is the querier route for crisis
+	QuerierRoute = ModuleName
 )
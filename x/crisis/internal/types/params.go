@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/x/params"
 )
@@ -13,11 +15,69 @@ const (
 var (
 	// key for constant fee parameter
 	ParamStoreKeyConstantFee = []byte("ConstantFee")
+	// key for the invariant policies parameter
+	ParamStoreKeyInvariantPolicies = []byte("InvariantPolicies")
+)
+
+// Policies applied when an invariant is broken
+const (
+	PolicyHalt    = "halt"    // halt the chain, the default policy
+	PolicyEvent   = "event"   // only emit an invariant-broken event
+	PolicyDisable = "disable" // do not check the invariant
 )
 
 // type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		ParamStoreKeyConstantFee, sdk.Coin{},
-	)
+		ParamStoreKeyInvariantPolicies, []InvariantPolicy{},
+	).RegisterValidator(ParamStoreKeyInvariantPolicies, validateInvariantPolicies)
+}
+
+func validateInvariantPolicies(_ sdk.Context, i interface{}) error {
+	policies, ok := i.([]InvariantPolicy)
+	if !ok {
+		return fmt.Errorf("invalid invariant policies type: %T", i)
+	}
+	return ValidateInvariantPolicies(policies)
+}
+
+// InvariantPolicy sets the policy applied to a registered invariant,
+// identified by its full route (module-name/invariant-route)
+type InvariantPolicy struct {
+	Route  string `json:"route" yaml:"route"`
+	Policy string `json:"policy" yaml:"policy"`
+}
+
+// NewInvariantPolicy creates a new InvariantPolicy object
+func NewInvariantPolicy(route, policy string) InvariantPolicy {
+	return InvariantPolicy{
+		Route:  route,
+		Policy: policy,
+	}
+}
+
+// ValidInvariantPolicy returns true if the policy is known
+func ValidInvariantPolicy(policy string) bool {
+	return policy == PolicyHalt || policy == PolicyEvent || policy == PolicyDisable
+}
+
+// ValidateInvariantPolicies checks that every policy is known and that no
+// invariant has several policies
+func ValidateInvariantPolicies(policies []InvariantPolicy) error {
+	routes := make(map[string]bool)
+	for _, p := range policies {
+		if p.Route == "" {
+			return fmt.Errorf("invariant policy route must not be empty")
+		}
+		if !ValidInvariantPolicy(p.Policy) {
+			return fmt.Errorf("invalid policy %s for invariant %s, must be one of %s, %s or %s",
+				p.Policy, p.Route, PolicyHalt, PolicyEvent, PolicyDisable)
+		}
+		if routes[p.Route] {
+			return fmt.Errorf("duplicate policy for invariant %s", p.Route)
+		}
+		routes[p.Route] = true
+	}
+	return nil
 }
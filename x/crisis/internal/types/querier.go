@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Query endpoints supported by the crisis querier
+const (
+	QueryInvariants = "invariants"
+)
+
+// InvariantCheck is the result of the last check of an invariant
+type InvariantCheck struct {
+	Height  int64  `json:"height" yaml:"height"`
+	Broken  bool   `json:"broken" yaml:"broken"`
+	Message string `json:"message,omitempty" yaml:"message"`
+}
+
+// InvariantStatus describes a registered invariant, its policy and the result
+// of its last check by the queried node
+type InvariantStatus struct {
+	ModuleName string          `json:"module_name" yaml:"module_name"`
+	Route      string          `json:"route" yaml:"route"`
+	Policy     string          `json:"policy" yaml:"policy"`
+	LastCheck  *InvariantCheck `json:"last_check,omitempty" yaml:"last_check"` // nil if the invariant has not been checked yet
+}
+
+// InvariantStatuses is a collection of InvariantStatus
+type InvariantStatuses []InvariantStatus
+
+func (s InvariantStatuses) String() string {
+	out := "Invariants:\n"
+	for _, status := range s {
+		result := "not checked yet"
+		if check := status.LastCheck; check != nil {
+			result = fmt.Sprintf("ok at height %d", check.Height)
+			if check.Broken {
+				result = fmt.Sprintf("broken at height %d: %s", check.Height, check.Message)
+			}
+		}
+		out += fmt.Sprintf("  %s/%s (policy: %s): %s\n",
+			status.ModuleName, status.Route, status.Policy, result)
+	}
+	return strings.TrimSpace(out)
+}
@@ -30,3 +30,17 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 }
+
+// restake the rewards of the delegations opted in to auto-restaking, pay out
+// the continuous funds, and record the reward ledger when enabled on this node
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.AutoRestakeUpgrade) {
+		k.AutoRestake(ctx)
+	}
+
+	if sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.ContinuousFundUpgrade) {
+		k.PayContinuousFunds(ctx)
+	}
+
+	k.RecordRewardLedger(ctx)
+}
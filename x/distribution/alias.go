@@ -19,15 +19,30 @@ const (
 	CodeNoDistributionInfo           = types.CodeNoDistributionInfo
 	CodeNoValidatorCommission        = types.CodeNoValidatorCommission
 	CodeSetWithdrawAddrDisabled      = types.CodeSetWithdrawAddrDisabled
+	CodeTooManyDelegations           = types.CodeTooManyDelegations
+	CodeRewardLedgerDisabled         = types.CodeRewardLedgerDisabled
+	CodeUnknownContinuousFund        = types.CodeUnknownContinuousFund
 	ModuleName                       = types.ModuleName
 	StoreKey                         = types.StoreKey
 	RouterKey                        = types.RouterKey
 	QuerierRoute                     = types.QuerierRoute
 	ProposalTypeCommunityPoolSpend   = types.ProposalTypeCommunityPoolSpend
+	ProposalTypeContinuousFund       = types.ProposalTypeContinuousFund
+	ProposalTypeCancelContinuousFund = types.ProposalTypeCancelContinuousFund
 	QueryParams                      = types.QueryParams
 	QueryValidatorOutstandingRewards = types.QueryValidatorOutstandingRewards
 	QueryValidatorCommission         = types.QueryValidatorCommission
 	QueryValidatorSlashes            = types.QueryValidatorSlashes
+	QueryDelegatorAutoRestakes       = types.QueryDelegatorAutoRestakes
+	QueryValidatorRewardHistory      = types.QueryValidatorRewardHistory
+	QueryCommunityPoolHistory        = types.QueryCommunityPoolHistory
+	QueryContinuousFunds             = types.QueryContinuousFunds
+	QueryContinuousFund              = types.QueryContinuousFund
+	DefaultRewardHistoryLimit        = types.DefaultRewardHistoryLimit
+	MaxRewardHistoryLimit            = types.MaxRewardHistoryLimit
+	DefaultAutoRestakeInterval       = types.DefaultAutoRestakeInterval
+	DefaultMaxAutoRestakesPerBlock   = types.DefaultMaxAutoRestakesPerBlock
+	MaxWithdrawAllRewardsDelegations = types.MaxWithdrawAllRewardsDelegations
 	QueryDelegationRewards           = types.QueryDelegationRewards
 	QueryDelegatorTotalRewards       = types.QueryDelegatorTotalRewards
 	QueryDelegatorValidators         = types.QueryDelegatorValidators
@@ -65,8 +80,11 @@ var (
 	GetValidatorSlashEventPrefix               = keeper.GetValidatorSlashEventPrefix
 	GetValidatorSlashEventKeyPrefix            = keeper.GetValidatorSlashEventKeyPrefix
 	GetValidatorSlashEventKey                  = keeper.GetValidatorSlashEventKey
+	GetContinuousFundKey                       = keeper.GetContinuousFundKey
 	ParamKeyTable                              = keeper.ParamKeyTable
 	HandleCommunityPoolSpendProposal           = keeper.HandleCommunityPoolSpendProposal
+	HandleContinuousFundProposal               = keeper.HandleContinuousFundProposal
+	HandleCancelContinuousFundProposal         = keeper.HandleCancelContinuousFundProposal
 	NewQuerier                                 = keeper.NewQuerier
 	MakeTestCodec                              = keeper.MakeTestCodec
 	CreateTestInputDefault                     = keeper.CreateTestInputDefault
@@ -80,6 +98,10 @@ var (
 	ErrNoValidatorDistInfo                     = types.ErrNoValidatorDistInfo
 	ErrNoValidatorCommission                   = types.ErrNoValidatorCommission
 	ErrSetWithdrawAddrDisabled                 = types.ErrSetWithdrawAddrDisabled
+	ErrTooManyDelegations                      = types.ErrTooManyDelegations
+	ErrRewardLedgerDisabled                    = types.ErrRewardLedgerDisabled
+	ErrInvalidContinuousFund                   = types.ErrInvalidContinuousFund
+	ErrUnknownContinuousFund                   = types.ErrUnknownContinuousFund
 	ErrBadDistribution                         = types.ErrBadDistribution
 	ErrInvalidProposalAmount                   = types.ErrInvalidProposalAmount
 	ErrEmptyProposalRecipient                  = types.ErrEmptyProposalRecipient
@@ -90,7 +112,19 @@ var (
 	NewMsgSetWithdrawAddress                   = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
+	NewMsgWithdrawAllRewards                   = types.NewMsgWithdrawAllRewards
+	NewMsgSetAutoRestake                       = types.NewMsgSetAutoRestake
+	NewAutoRestakeRecord                       = types.NewAutoRestakeRecord
+	NewRewardLedger                            = keeper.NewRewardLedger
+	NewValidatorRewardRecord                   = types.NewValidatorRewardRecord
+	NewCommunityPoolRecord                     = types.NewCommunityPoolRecord
+	NewQueryValidatorRewardHistoryParams       = types.NewQueryValidatorRewardHistoryParams
+	NewQueryCommunityPoolHistoryParams         = types.NewQueryCommunityPoolHistoryParams
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
+	NewContinuousFundProposal                  = types.NewContinuousFundProposal
+	NewCancelContinuousFundProposal            = types.NewCancelContinuousFundProposal
+	NewContinuousFund                          = types.NewContinuousFund
+	NewQueryContinuousFundParams               = types.NewQueryContinuousFundParams
 	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
 	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
 	NewQueryValidatorSlashesParams             = types.NewQueryValidatorSlashesParams
@@ -114,10 +148,16 @@ var (
 	ValidatorCurrentRewardsPrefix        = keeper.ValidatorCurrentRewardsPrefix
 	ValidatorAccumulatedCommissionPrefix = keeper.ValidatorAccumulatedCommissionPrefix
 	ValidatorSlashEventPrefix            = keeper.ValidatorSlashEventPrefix
+	AutoRestakePrefix                    = keeper.AutoRestakePrefix
+	AutoRestakeCursorKey                 = keeper.AutoRestakeCursorKey
+	ContinuousFundPrefix                 = keeper.ContinuousFundPrefix
+	NextContinuousFundIDKey              = keeper.NextContinuousFundIDKey
 	ParamStoreKeyCommunityTax            = keeper.ParamStoreKeyCommunityTax
 	ParamStoreKeyBaseProposerReward      = keeper.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = keeper.ParamStoreKeyBonusProposerReward
 	ParamStoreKeyWithdrawAddrEnabled     = keeper.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyAutoRestakeInterval     = keeper.ParamStoreKeyAutoRestakeInterval
+	ParamStoreKeyMaxAutoRestakesPerBlock = keeper.ParamStoreKeyMaxAutoRestakesPerBlock
 	TestAddrs                            = keeper.TestAddrs
 	ModuleCdc                            = types.ModuleCdc
 	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
@@ -126,10 +166,22 @@ var (
 	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
 	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
 	EventTypeProposerReward              = types.EventTypeProposerReward
+	EventTypeSetAutoRestake              = types.EventTypeSetAutoRestake
+	EventTypeAutoRestake                 = types.EventTypeAutoRestake
+	EventTypeContinuousFund              = types.EventTypeContinuousFund
+	EventTypeFundPayout                  = types.EventTypeFundPayout
+	EventTypeFundEnded                   = types.EventTypeFundEnded
+	EventTypeFundCancelled               = types.EventTypeFundCancelled
 	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
 	AttributeKeyValidator                = types.AttributeKeyValidator
+	AttributeKeyDelegator                = types.AttributeKeyDelegator
+	AttributeKeyEnabled                  = types.AttributeKeyEnabled
+	AttributeKeyFundID                   = types.AttributeKeyFundID
+	AttributeKeyRecipient                = types.AttributeKeyRecipient
 	AttributeValueCategory               = types.AttributeValueCategory
 	ProposalHandler                      = client.ProposalHandler
+	ContinuousFundProposalHandler        = client.ContinuousFundProposalHandler
+	CancelContinuousFundProposalHandler  = client.CancelContinuousFundProposalHandler
 )
 
 type (
@@ -149,7 +201,23 @@ type (
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
+	MsgWithdrawAllRewards                  = types.MsgWithdrawAllRewards
+	MsgSetAutoRestake                      = types.MsgSetAutoRestake
+	AutoRestakeRecord                      = types.AutoRestakeRecord
+	AutoRestakeRecords                     = types.AutoRestakeRecords
+	RewardLedger                           = keeper.RewardLedger
+	ValidatorRewardRecord                  = types.ValidatorRewardRecord
+	ValidatorRewardRecords                 = types.ValidatorRewardRecords
+	CommunityPoolRecord                    = types.CommunityPoolRecord
+	CommunityPoolRecords                   = types.CommunityPoolRecords
+	QueryValidatorRewardHistoryParams      = types.QueryValidatorRewardHistoryParams
+	QueryCommunityPoolHistoryParams        = types.QueryCommunityPoolHistoryParams
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
+	ContinuousFundProposal                 = types.ContinuousFundProposal
+	CancelContinuousFundProposal           = types.CancelContinuousFundProposal
+	ContinuousFund                         = types.ContinuousFund
+	ContinuousFunds                        = types.ContinuousFunds
+	QueryContinuousFundParams              = types.QueryContinuousFundParams
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
 	QueryValidatorSlashesParams            = types.QueryValidatorSlashesParams
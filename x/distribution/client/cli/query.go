@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/barkisnet/barkis/client"
 	"github.com/barkisnet/barkis/client/context"
@@ -16,6 +17,12 @@ import (
 	"github.com/barkisnet/barkis/x/distribution/types"
 )
 
+const (
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+	flagLimit       = "limit"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	distQueryCmd := &cobra.Command{
@@ -33,6 +40,11 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryDelegatorAutoRestakes(queryRoute, cdc),
+		GetCmdQueryValidatorRewardHistory(queryRoute, cdc),
+		GetCmdQueryCommunityPoolHistory(queryRoute, cdc),
+		GetCmdQueryContinuousFunds(queryRoute, cdc),
+		GetCmdQueryContinuousFund(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -262,3 +274,215 @@ $ %s query distr community-pool
 		},
 	}
 }
+
+// GetCmdQueryDelegatorAutoRestakes returns the command for fetching the
+// delegations of a delegator opted in to auto-restaking
+func GetCmdQueryDelegatorAutoRestakes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-restakes [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the delegations of a delegator opted in to auto-restaking",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegations of a delegator whose rewards are auto-restaked.
+
+Example:
+$ %s query distr auto-restakes cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorAutoRestakes)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.AutoRestakeRecords
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryValidatorRewardHistory returns the command for fetching the
+// recorded commission and outstanding rewards of a validator
+func GetCmdQueryValidatorRewardHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-history [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the recorded commission and outstanding rewards of a validator over a height range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the commission and outstanding rewards of a validator recorded in the reward
+ledger of the node, which must have the reward ledger enabled.
+
+Example:
+$ %s query distr reward-history cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --start-height 1000 --end-height 2000
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryValidatorRewardHistoryParams(valAddr,
+				viper.GetInt64(flagStartHeight), viper.GetInt64(flagEndHeight), viper.GetInt(flagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorRewardHistory)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.ValidatorRewardRecords
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	cmd.Flags().Int64(flagStartHeight, 0, "first height of the range")
+	cmd.Flags().Int64(flagEndHeight, 0, "last height of the range (0 for the latest height)")
+	cmd.Flags().Int(flagLimit, types.DefaultRewardHistoryLimit, "maximum number of records")
+	return cmd
+}
+
+// GetCmdQueryCommunityPoolHistory returns the command for fetching the
+// recorded community pool and its allocations
+func GetCmdQueryCommunityPoolHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the recorded community pool and its allocations over a height range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the community pool and the block rewards allocated to it recorded in the reward
+ledger of the node, which must have the reward ledger enabled. The other deposits to and the
+spends from the community pool are not recorded, they only show in the community pool itself.
+
+Example:
+$ %s query distr community-pool-history --start-height 1000 --end-height 2000
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryCommunityPoolHistoryParams(
+				viper.GetInt64(flagStartHeight), viper.GetInt64(flagEndHeight), viper.GetInt(flagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolHistory)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.CommunityPoolRecords
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	cmd.Flags().Int64(flagStartHeight, 0, "first height of the range")
+	cmd.Flags().Int64(flagEndHeight, 0, "last height of the range (0 for the latest height)")
+	cmd.Flags().Int(flagLimit, types.DefaultRewardHistoryLimit, "maximum number of records")
+	return cmd
+}
+
+// GetCmdQueryContinuousFunds returns the command for fetching the continuous
+// funds paid from the community pool
+func GetCmdQueryContinuousFunds(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "continuous-funds",
+		Args:  cobra.NoArgs,
+		Short: "Query the continuous funds paid from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the active continuous funds paid from the community pool.
+
+Example:
+$ %s query distr continuous-funds
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContinuousFunds)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var result types.ContinuousFunds
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryContinuousFund returns the command for fetching a single
+// continuous fund
+func GetCmdQueryContinuousFund(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "continuous-fund [fund-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a continuous fund paid from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the terms and the amount paid so far of an active continuous fund.
+
+Example:
+$ %s query distr continuous-fund 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			fundID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("fund-id %s not a valid uint, please input a valid fund-id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryContinuousFundParams(fundID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContinuousFund)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.ContinuousFund
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
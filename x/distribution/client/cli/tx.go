@@ -3,6 +3,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -16,6 +17,7 @@ import (
 	"github.com/barkisnet/barkis/x/auth"
 	"github.com/barkisnet/barkis/x/auth/client/utils"
 	"github.com/barkisnet/barkis/x/gov"
+	govcli "github.com/barkisnet/barkis/x/gov/client/cli"
 
 	"github.com/barkisnet/barkis/x/distribution/client/common"
 	"github.com/barkisnet/barkis/x/distribution/types"
@@ -26,6 +28,7 @@ var (
 	flagIsValidator       = "is-validator"
 	flagComission         = "commission"
 	flagMaxMessagesPerTx  = "max-msgs"
+	flagSingleMsg         = "single-msg"
 )
 
 const (
@@ -46,6 +49,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
 		GetCmdWithdrawAllRewards(cdc, storeKey),
+		GetCmdSetAutoRestake(cdc),
 	)...)
 
 	return distTxCmd
@@ -128,12 +132,18 @@ func GetCmdWithdrawAllRewards(cdc *codec.Codec, queryRoute string) *cobra.Comman
 		Use:   "withdraw-all-rewards",
 		Short: "withdraw all delegations rewards for a delegator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw all rewards for a single delegator.
+			fmt.Sprintf(`Withdraw all rewards for a single delegator, with one message per delegation
+split over several transactions with --max-msgs.
+
+With --single-msg, the rewards are withdrawn in a single message along with the
+validator's commission if the delegator is a validator operator. The message is
+only accepted once the withdraw-all-rewards upgrade is applied on the chain.
 
 Example:
-$ %s tx distr withdraw-all-rewards --from mykey
+$ %s tx distr withdraw-all-rewards --max-msgs 10 --from mykey
+$ %s tx distr withdraw-all-rewards --single-msg --from mykey
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -143,6 +153,11 @@ $ %s tx distr withdraw-all-rewards --from mykey
 
 			delAddr := cliCtx.GetFromAddress()
 
+			if viper.GetBool(flagSingleMsg) {
+				msg := types.NewMsgWithdrawAllRewards(delAddr)
+				return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			}
+
 			// The transaction cannot be generated offline since it requires a query
 			// to get all the validators.
 			if cliCtx.GenerateOnly {
@@ -159,6 +174,7 @@ $ %s tx distr withdraw-all-rewards --from mykey
 		},
 	}
 
+	cmd.Flags().Bool(flagSingleMsg, false, "withdraw all rewards and the commission in a single message, once the chain upgrade is applied")
 	cmd.Flags().Int(flagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
 	return cmd
 }
@@ -195,6 +211,48 @@ $ %s tx set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from m
 	}
 }
 
+// command to opt a delegation in or out of auto-restaking its rewards
+func GetCmdSetAutoRestake(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-restake [validator-addr] [true|false]",
+		Short: "enable or disable auto-restaking of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable auto-restaking of the rewards of a delegation. When enabled, the
+rewards are periodically withdrawn and delegated again to the same validator. Rewards
+are not restaked while the withdraw address differs from the delegator address.
+
+Example:
+$ %s tx distr set-auto-restake cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(delAddr, valAddr, enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -244,6 +302,135 @@ Where proposal.json contains:
 			content := types.NewCommunityPoolSpendProposal(proposal.Title, proposal.Description, proposal.Recipient, proposal.Amount)
 
 			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg.Expedited = viper.GetBool(govcli.FlagExpedited)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitContinuousFundProposal implements the command to submit a continuous fund proposal
+func GetCmdSubmitContinuousFundProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-fund [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a continuous fund proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pay an amount from the community pool every period of
+blocks, along with an initial deposit. The fund ends once the total amount has
+been paid or the end time is reached, at least one of them must be set.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal continuous-fund <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Continuous Fund",
+  "description": "Pay me some Atoms every day!",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount_per_period": [
+    {
+      "denom": "stake",
+      "amount": "100"
+    }
+  ],
+  "period": "17280",
+  "total_amount": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "end_time": "2021-01-01T00:00:00Z",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseContinuousFundProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewContinuousFundProposal(proposal.Title, proposal.Description, proposal.Recipient,
+				proposal.AmountPerPeriod, proposal.Period, proposal.TotalAmount, proposal.EndTime)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg.Expedited = viper.GetBool(govcli.FlagExpedited)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelContinuousFundProposal implements the command to submit a
+// proposal cancelling a continuous fund
+func GetCmdSubmitCancelContinuousFundProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-continuous-fund [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal cancelling a continuous fund",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel an active continuous fund along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-continuous-fund <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Continuous Fund",
+  "description": "Stop paying fund 1",
+  "fund_id": "1",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCancelContinuousFundProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCancelContinuousFundProposal(proposal.Title, proposal.Description, proposal.FundID)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg.Expedited = viper.GetBool(govcli.FlagExpedited)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"io/ioutil"
+	"time"
 
 	"github.com/barkisnet/barkis/codec"
 	sdk "github.com/barkisnet/barkis/types"
@@ -16,6 +17,26 @@ type (
 		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// ContinuousFundProposalJSON defines a ContinuousFundProposal with a deposit
+	ContinuousFundProposalJSON struct {
+		Title           string         `json:"title" yaml:"title"`
+		Description     string         `json:"description" yaml:"description"`
+		Recipient       sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		AmountPerPeriod sdk.Coins      `json:"amount_per_period" yaml:"amount_per_period"`
+		Period          int64          `json:"period" yaml:"period"`
+		TotalAmount     sdk.Coins      `json:"total_amount" yaml:"total_amount"`
+		EndTime         time.Time      `json:"end_time" yaml:"end_time"`
+		Deposit         sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// CancelContinuousFundProposalJSON defines a CancelContinuousFundProposal with a deposit
+	CancelContinuousFundProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		FundID      uint64    `json:"fund_id" yaml:"fund_id"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseCommunityPoolSpendProposalJSON reads and parses a CommunityPoolSpendProposalJSON from a file.
@@ -33,3 +54,35 @@ func ParseCommunityPoolSpendProposalJSON(cdc *codec.Codec, proposalFile string)
 
 	return proposal, nil
 }
+
+// ParseContinuousFundProposalJSON reads and parses a ContinuousFundProposalJSON from a file.
+func ParseContinuousFundProposalJSON(cdc *codec.Codec, proposalFile string) (ContinuousFundProposalJSON, error) {
+	proposal := ContinuousFundProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelContinuousFundProposalJSON reads and parses a CancelContinuousFundProposalJSON from a file.
+func ParseCancelContinuousFundProposalJSON(cdc *codec.Codec, proposalFile string) (CancelContinuousFundProposalJSON, error) {
+	proposal := CancelContinuousFundProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
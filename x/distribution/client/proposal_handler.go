@@ -8,5 +8,7 @@ import (
 
 // param change proposal handler
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ProposalHandler                     = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ContinuousFundProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitContinuousFundProposal, rest.ContinuousFundProposalRESTHandler)
+	CancelContinuousFundProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelContinuousFundProposal, rest.CancelContinuousFundProposalRESTHandler)
 )
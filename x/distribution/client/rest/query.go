@@ -33,6 +33,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		delegatorWithdrawalAddrHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the delegations opted in to auto-restaking
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_restakes",
+		delegatorAutoRestakesHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Validator distribution information
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
@@ -63,6 +69,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		communityPoolHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the continuous funds paid from the community pool
+	r.HandleFunc(
+		"/distribution/continuous_funds",
+		continuousFundsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get a single continuous fund
+	r.HandleFunc(
+		"/distribution/continuous_funds/{fundID}",
+		continuousFundHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the recorded community pool and its allocations over a height range
+	r.HandleFunc(
+		"/distribution/community_pool/history",
+		communityPoolHistoryHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the recorded commission and outstanding rewards of a validator over a height range
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/reward_history",
+		validatorRewardHistoryHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query the total rewards balance from all delegations
@@ -126,6 +156,108 @@ func delegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext, queryRoute stri
 	}
 }
 
+// HTTP request handler to query the delegations opted in to auto-restaking
+func delegatorAutoRestakesHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorAutoRestakes), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// parse the optional start_height, end_height and limit of a reward history query
+func parseRewardHistoryRange(w http.ResponseWriter, r *http.Request) (start, end int64, limit int, ok bool) {
+	if s := r.FormValue("start_height"); s != "" {
+		if start, ok = rest.ParseInt64OrReturnBadRequest(w, s); !ok {
+			return
+		}
+	}
+	if s := r.FormValue("end_height"); s != "" {
+		if end, ok = rest.ParseInt64OrReturnBadRequest(w, s); !ok {
+			return
+		}
+	}
+	if s := r.FormValue("limit"); s != "" {
+		var n int64
+		if n, ok = rest.ParseInt64OrReturnBadRequest(w, s); !ok {
+			return
+		}
+		limit = int(n)
+	}
+	return start, end, limit, true
+}
+
+// HTTP request handler to query the recorded rewards of a validator
+func validatorRewardHistoryHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		start, end, limit, ok := parseRewardHistoryRange(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryValidatorRewardHistoryParams(validatorAddr, start, end, limit))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorRewardHistory), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the recorded community pool
+func communityPoolHistoryHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start, end, limit, ok := parseRewardHistoryRange(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryCommunityPoolHistoryParams(start, end, limit))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolHistory), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // ValidatorDistInfo defines the properties of
 // validator distribution information response.
 type ValidatorDistInfo struct {
@@ -250,6 +382,51 @@ func communityPoolHandler(cliCtx context.CLIContext, queryRoute string) http.Han
 	}
 }
 
+// HTTP request handler to query the continuous funds
+func continuousFundsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContinuousFunds), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query a single continuous fund
+func continuousFundHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strFundID := mux.Vars(r)["fundID"]
+		fundID, ok := rest.ParseUint64OrReturnBadRequest(w, strFundID)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bin := cliCtx.Codec.MustMarshalJSON(types.NewQueryContinuousFundParams(fundID))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContinuousFund), bin)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // HTTP request handler to query the outstanding rewards
 func outstandingRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -28,6 +28,22 @@ func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler
 	}
 }
 
+// ContinuousFundProposalRESTHandler returns a ProposalRESTHandler that exposes the continuous fund REST handler with a given sub-route.
+func ContinuousFundProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "continuous_fund",
+		Handler:  postContinuousFundProposalHandlerFn(cliCtx),
+	}
+}
+
+// CancelContinuousFundProposalRESTHandler returns a ProposalRESTHandler that exposes the cancel continuous fund REST handler with a given sub-route.
+func CancelContinuousFundProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_continuous_fund",
+		Handler:  postCancelContinuousFundProposalHandlerFn(cliCtx),
+	}
+}
+
 func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req CommunityPoolSpendProposalReq
@@ -43,31 +59,88 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		content := types.NewCommunityPoolSpendProposal(req.Title, req.Description, req.Recipient, req.Amount)
 
 		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg.Expedited = req.Expedited
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		writeProposalResponse(w, cliCtx, req.BaseReq, msg)
+	}
+}
+
+func postContinuousFundProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ContinuousFundProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewContinuousFundProposal(req.Title, req.Description, req.Recipient,
+			req.AmountPerPeriod, req.Period, req.TotalAmount, req.EndTime)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg.Expedited = req.Expedited
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		// derive the from account address and name from the Keybase
-		var fromAddress sdk.AccAddress
-		var fromName string
-		var err error
-		if req.BaseReq.GenerateOnly {
-			fromAddress, err = sdk.AccAddressFromBech32(req.BaseReq.From)
-			if err != nil {
-				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-				return
-			}
-			fromName=""
-		} else {
-			fromAddress, fromName, err = context.GetFromFieldsFromAddr(req.BaseReq.From)
-			if err != nil {
-				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-				return
-			}
+		writeProposalResponse(w, cliCtx, req.BaseReq, msg)
+	}
+}
+
+func postCancelContinuousFundProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelContinuousFundProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelContinuousFundProposal(req.Title, req.Description, req.FundID)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg.Expedited = req.Expedited
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
 
-		cliCtx = cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(req.BaseReq.BroadcastMode)
-		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+		writeProposalResponse(w, cliCtx, req.BaseReq, msg)
+	}
+}
+
+// derive the from account address and name from the Keybase, and write the
+// proposal submission tx to the response
+func writeProposalResponse(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq, msg sdk.Msg) {
+	var fromAddress sdk.AccAddress
+	var fromName string
+	var err error
+	if baseReq.GenerateOnly {
+		fromAddress, err = sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		fromName = ""
+	} else {
+		fromAddress, fromName, err = context.GetFromFieldsFromAddr(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 	}
+
+	cliCtx = cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(baseReq.BroadcastMode)
+	utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 }
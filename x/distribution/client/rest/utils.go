@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"time"
+
 	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/types/rest"
 )
@@ -16,5 +18,34 @@ type (
 		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Expedited   bool           `json:"expedited" yaml:"expedited"`
+	}
+
+	// ContinuousFundProposalReq defines a continuous fund proposal request body.
+	ContinuousFundProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title           string         `json:"title" yaml:"title"`
+		Description     string         `json:"description" yaml:"description"`
+		Recipient       sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		AmountPerPeriod sdk.Coins      `json:"amount_per_period" yaml:"amount_per_period"`
+		Period          int64          `json:"period" yaml:"period"`
+		TotalAmount     sdk.Coins      `json:"total_amount" yaml:"total_amount"`
+		EndTime         time.Time      `json:"end_time" yaml:"end_time"`
+		Proposer        sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit         sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Expedited       bool           `json:"expedited" yaml:"expedited"`
+	}
+
+	// CancelContinuousFundProposalReq defines a cancel continuous fund proposal request body.
+	CancelContinuousFundProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		FundID      uint64         `json:"fund_id" yaml:"fund_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Expedited   bool           `json:"expedited" yaml:"expedited"`
 	}
 )
@@ -40,6 +40,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, ar := range data.AutoRestakes {
+		keeper.SetAutoRestake(ctx, ar.DelegatorAddress, ar.ValidatorAddress)
+	}
+	for _, fund := range data.ContinuousFunds {
+		keeper.SetContinuousFund(ctx, fund)
+	}
+	if data.NextContinuousFundID > 0 {
+		keeper.SetNextContinuousFundID(ctx, data.NextContinuousFundID)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -138,6 +147,14 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 			return false
 		},
 	)
+	autoRestakes := make([]types.AutoRestakeRecord, 0)
+	keeper.IterateAutoRestakes(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			autoRestakes = append(autoRestakes, types.NewAutoRestakeRecord(del, val))
+			return false
+		},
+	)
 	return types.NewGenesisState(feePool, communityTax, baseProposerRewards, bonusProposerRewards, withdrawAddrEnabled,
-		dwi, pp, outstanding, acc, his, cur, dels, slashes)
+		dwi, pp, outstanding, acc, his, cur, dels, slashes, autoRestakes,
+		keeper.GetContinuousFunds(ctx), keeper.GetNextContinuousFundID(ctx))
 }
@@ -37,6 +37,7 @@ func (k Keeper) AllocateTokens(
 	if totalPreviousPower == 0 {
 		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected)
 		k.SetFeePool(ctx, feePool)
+		k.recordCommunityPoolAllocation(ctx, feesCollected)
 		return
 	}
 
@@ -97,6 +98,7 @@ func (k Keeper) AllocateTokens(
 	// allocate community funding
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining)
 	k.SetFeePool(ctx, feePool)
+	k.recordCommunityPoolAllocation(ctx, remaining)
 }
 
 // AllocateTokensToValidator allocate tokens to a particular validator, splitting according to commission
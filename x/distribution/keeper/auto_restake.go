@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/distribution/types"
+)
+
+// SetAutoRestakeEnabled opts a delegation in or out of auto-restaking its rewards
+func (k Keeper) SetAutoRestakeEnabled(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) sdk.Error {
+	if enabled {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegationDistInfo(k.codespace)
+		}
+		k.SetAutoRestake(ctx, delAddr, valAddr)
+	} else {
+		k.DeleteAutoRestake(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// check whether a delegation is opted in to auto-restaking
+func (k Keeper) HasAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetAutoRestakeKey(delAddr, valAddr))
+}
+
+// opt a delegation in to auto-restaking
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetAutoRestakeKey(delAddr, valAddr), []byte{0x01})
+}
+
+// delete the auto-restaking opt-in of a delegation
+func (k Keeper) DeleteAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetAutoRestakeKey(delAddr, valAddr))
+}
+
+// iterate over the delegations of a delegator opted in to auto-restaking
+func (k Keeper) IterateDelegatorAutoRestakes(ctx sdk.Context, delAddr sdk.AccAddress,
+	handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+
+	k.iterateAutoRestakes(ctx, GetDelegatorAutoRestakesPrefix(delAddr), handler)
+}
+
+// iterate over all the delegations opted in to auto-restaking
+func (k Keeper) IterateAutoRestakes(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	k.iterateAutoRestakes(ctx, AutoRestakePrefix, handler)
+}
+
+func (k Keeper) iterateAutoRestakes(ctx sdk.Context, prefix []byte, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := GetAutoRestakeAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// AutoRestake restakes the rewards of the delegations opted in to
+// auto-restaking. A round starts every AutoRestakeInterval blocks and
+// restakes at most MaxAutoRestakesPerBlock delegations per block, resuming
+// in the next block from where it stopped until all delegations are done.
+func (k Keeper) AutoRestake(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	cursor := store.Get(AutoRestakeCursorKey)
+	if cursor == nil {
+		interval := k.GetAutoRestakeInterval(ctx)
+		if interval <= 0 || ctx.BlockHeight()%interval != 0 {
+			return
+		}
+	}
+
+	start := AutoRestakePrefix
+	if cursor != nil {
+		// resume right after the last delegation restaked
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+
+	var keys [][]byte
+	max := int(k.GetMaxAutoRestakesPerBlock(ctx))
+	iter := store.Iterator(start, sdk.PrefixEndBytes(AutoRestakePrefix))
+	for ; iter.Valid() && len(keys) < max; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	done := !iter.Valid()
+	iter.Close()
+
+	for _, key := range keys {
+		delAddr, valAddr := GetAutoRestakeAddresses(key)
+		k.restakeRewards(ctx, delAddr, valAddr)
+	}
+
+	if done || len(keys) == 0 {
+		store.Delete(AutoRestakeCursorKey)
+	} else {
+		store.Set(AutoRestakeCursorKey, keys[len(keys)-1])
+	}
+}
+
+// restakeRewards withdraws the rewards of a delegation and delegates the bond
+// denom part of them back to the validator. Delegations withdrawing their
+// rewards to another address are skipped, as the delegator does not receive them.
+func (k Keeper) restakeRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if val == nil || del == nil {
+		k.DeleteAutoRestake(ctx, delAddr, valAddr)
+		return
+	}
+
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return
+	}
+
+	// withdraw and delegate atomically, so that a failed delegation leaves
+	// the rewards to be withdrawn later
+	cacheCtx, write := ctx.CacheContext()
+
+	rewards, err := k.withdrawDelegationRewards(cacheCtx, val, del)
+	if err != nil {
+		k.Logger(ctx).Error("failed to withdraw rewards to restake", "delegator", delAddr, "validator", valAddr, "err", err)
+		return
+	}
+	k.initializeDelegation(cacheCtx, valAddr, delAddr)
+
+	amount := rewards.AmountOf(k.stakingKeeper.BondDenom(ctx))
+	if !amount.IsPositive() {
+		return
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(cacheCtx, valAddr)
+	if !found {
+		return
+	}
+
+	if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, sdk.Unbonded, validator, true); err != nil {
+		k.Logger(ctx).Error("failed to restake rewards", "delegator", delAddr, "validator", valAddr, "err", err)
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRestake,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/staking"
+)
+
+func TestSetAutoRestakeEnabled(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// no delegation yet
+	err := k.SetAutoRestakeEnabled(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1, true)
+	require.NotNil(t, err)
+	require.False(t, k.HasAutoRestake(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1))
+
+	// create validator with self delegation
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	err = k.SetAutoRestakeEnabled(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1, true)
+	require.Nil(t, err)
+	require.True(t, k.HasAutoRestake(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1))
+
+	var count int
+	k.IterateDelegatorAutoRestakes(ctx, sdk.AccAddress(valOpAddr1),
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			require.Equal(t, sdk.AccAddress(valOpAddr1), del)
+			require.Equal(t, valOpAddr1, val)
+			count++
+			return false
+		},
+	)
+	require.Equal(t, 1, count)
+
+	err = k.SetAutoRestakeEnabled(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1, false)
+	require.Nil(t, err)
+	require.False(t, k.HasAutoRestake(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1))
+}
+
+func TestAutoRestake(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// opt in to auto-restaking
+	require.Nil(t, k.SetAutoRestakeEnabled(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1, true))
+	k.SetAutoRestakeInterval(ctx, 10)
+
+	// allocate some rewards
+	val := sk.Validator(ctx, valOpAddr1)
+	initial := sdk.TokensFromConsensusPower(10)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// nothing restaked out of the interval
+	ctx = ctx.WithBlockHeight(9)
+	k.AutoRestake(ctx)
+	del := sk.Delegation(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1)
+	require.Equal(t, valTokens.ToDec(), del.GetShares())
+
+	// rewards are restaked at the interval
+	ctx = ctx.WithBlockHeight(10)
+	k.AutoRestake(ctx)
+	del = sk.Delegation(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1)
+	require.Equal(t, valTokens.Add(initial.QuoRaw(2)).ToDec(), del.GetShares())
+
+	// the account balance is left untouched
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Sub(valTokens))},
+		ak.GetAccount(ctx, sdk.AccAddress(valOpAddr1)).GetCoins(),
+	)
+	require.True(t, k.GetDelegatorStartingInfo(ctx, valOpAddr1, sdk.AccAddress(valOpAddr1)).Stake.IsPositive())
+}
+
+func TestAutoRestakeMaxPerBlock(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validators with self delegations opted in to auto-restaking
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	for i, valAddr := range []sdk.ValAddress{valOpAddr1, valOpAddr2, valOpAddr3} {
+		msg := staking.NewMsgCreateValidator(valAddr, []crypto.PubKey{valConsPk1, valConsPk2, valConsPk3}[i],
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+		require.True(t, sh(ctx, msg).IsOK())
+		require.Nil(t, k.SetAutoRestakeEnabled(ctx, sdk.AccAddress(valAddr), valAddr, true))
+	}
+
+	k.SetAutoRestakeInterval(ctx, 10)
+	k.SetMaxAutoRestakesPerBlock(ctx, 2)
+	store := ctx.KVStore(k.storeKey)
+
+	// a round spans two blocks
+	ctx = ctx.WithBlockHeight(10)
+	k.AutoRestake(ctx)
+	require.True(t, store.Has(AutoRestakeCursorKey))
+
+	ctx = ctx.WithBlockHeight(11)
+	k.AutoRestake(ctx)
+	require.False(t, store.Has(AutoRestakeCursorKey))
+
+	// no new round until the next interval
+	ctx = ctx.WithBlockHeight(12)
+	k.AutoRestake(ctx)
+	require.False(t, store.Has(AutoRestakeCursorKey))
+}
+
+func TestUpdateAutoRestakeParams(t *testing.T) {
+	ctx, _, k, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	require.NoError(t, k.paramSpace.Update(ctx, ParamStoreKeyAutoRestakeInterval, []byte(`"100"`)))
+	require.Equal(t, int64(100), k.GetAutoRestakeInterval(ctx))
+	require.NoError(t, k.paramSpace.Update(ctx, ParamStoreKeyAutoRestakeInterval, []byte(`"0"`)))
+	require.Equal(t, int64(0), k.GetAutoRestakeInterval(ctx))
+	require.Error(t, k.paramSpace.Update(ctx, ParamStoreKeyAutoRestakeInterval, []byte(`"-1"`)))
+	require.Equal(t, int64(0), k.GetAutoRestakeInterval(ctx))
+
+	require.NoError(t, k.paramSpace.Update(ctx, ParamStoreKeyMaxAutoRestakesPerBlock, []byte(`10`)))
+	require.Equal(t, uint16(10), k.GetMaxAutoRestakesPerBlock(ctx))
+	require.Error(t, k.paramSpace.Update(ctx, ParamStoreKeyMaxAutoRestakesPerBlock, []byte(`0`)))
+	require.Equal(t, uint16(10), k.GetMaxAutoRestakesPerBlock(ctx))
+}
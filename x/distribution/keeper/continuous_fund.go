@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/distribution/types"
+)
+
+// get a continuous fund
+func (k Keeper) GetContinuousFund(ctx sdk.Context, id uint64) (fund types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetContinuousFundKey(id))
+	if b == nil {
+		return fund, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &fund)
+	return fund, true
+}
+
+// set a continuous fund, and queue it for its next payout after the current height
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(fund)
+	store.Set(GetContinuousFundKey(fund.ID), b)
+	store.Set(GetContinuousFundQueueKey(fund.NextPayoutHeight(ctx.BlockHeight()), fund.ID), []byte{0x01})
+}
+
+// delete a continuous fund, its queued payout is dropped once due
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetContinuousFundKey(id))
+}
+
+// dequeue the IDs of the continuous funds queued for a payout up to a height
+func (k Keeper) dequeueContinuousFunds(ctx sdk.Context, height int64) (ids []uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(ContinuousFundQueuePrefix, sdk.PrefixEndBytes(GetContinuousFundQueueHeightPrefix(height)))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		ids = append(ids, GetContinuousFundQueueFundID(iter.Key()))
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return ids
+}
+
+// iterate over the continuous funds
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(fund types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, ContinuousFundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &fund)
+		if handler(fund) {
+			break
+		}
+	}
+}
+
+// get all the continuous funds
+func (k Keeper) GetContinuousFunds(ctx sdk.Context) (funds types.ContinuousFunds) {
+	funds = types.ContinuousFunds{}
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		funds = append(funds, fund)
+		return false
+	})
+	return funds
+}
+
+// get the ID of the next continuous fund
+func (k Keeper) GetNextContinuousFundID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(NextContinuousFundIDKey)
+	if b == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// set the ID of the next continuous fund
+func (k Keeper) SetNextContinuousFundID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(NextContinuousFundIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// PayContinuousFunds pays out the continuous funds due at the current height
+// from the community pool. The funds which have ended are deleted when their
+// next payout is due instead. A payout the community pool cannot cover is
+// skipped.
+func (k Keeper) PayContinuousFunds(ctx sdk.Context) {
+	for _, id := range k.dequeueContinuousFunds(ctx, ctx.BlockHeight()) {
+		fund, found := k.GetContinuousFund(ctx, id)
+		if !found {
+			// the fund has been cancelled
+			continue
+		}
+		if fund.IsEnded(ctx.BlockHeader().Time) {
+			k.endContinuousFund(ctx, fund)
+			continue
+		}
+		if !fund.IsPayoutHeight(ctx.BlockHeight()) {
+			k.SetContinuousFund(ctx, fund)
+			continue
+		}
+
+		payout := fund.NextPayout()
+		if err := k.DistributeFromFeePool(ctx, payout, fund.Recipient); err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("skipped continuous fund %d payout of %s: %s", fund.ID, payout, err.Result().Log))
+			k.SetContinuousFund(ctx, fund)
+			continue
+		}
+
+		fund.Paid = fund.Paid.Add(payout)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFundPayout,
+				sdk.NewAttribute(types.AttributeKeyFundID, fmt.Sprintf("%d", fund.ID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, payout.String()),
+			),
+		)
+
+		if fund.IsEnded(ctx.BlockHeader().Time) {
+			k.endContinuousFund(ctx, fund)
+			continue
+		}
+		k.SetContinuousFund(ctx, fund)
+	}
+}
+
+func (k Keeper) endContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	k.DeleteContinuousFund(ctx, fund.ID)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundEnded,
+			sdk.NewAttribute(types.AttributeKeyFundID, fmt.Sprintf("%d", fund.ID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fund.Paid.String()),
+		),
+	)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/distribution/types"
+)
+
+func TestPayContinuousFunds(t *testing.T) {
+	ctx, ak, k, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	// fund the community pool
+	pool := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(250)))
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.Nil(t, distrAcc.SetCoins(pool))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoins(pool)
+	k.SetFeePool(ctx, feePool)
+
+	recipient := delAddr1
+	initial := ak.GetAccount(ctx, recipient).GetCoins()
+	perPeriod := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(40)))
+	total := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	// fund paying every 10 blocks up to a total amount
+	k.SetContinuousFund(ctx, types.NewContinuousFund(1, recipient, perPeriod, 10, total, time.Time{}, 0))
+	k.SetNextContinuousFundID(ctx, 2)
+
+	// nothing paid out of the period
+	ctx = ctx.WithBlockHeight(5)
+	k.PayContinuousFunds(ctx)
+	require.Equal(t, initial, ak.GetAccount(ctx, recipient).GetCoins())
+
+	ctx = ctx.WithBlockHeight(10)
+	k.PayContinuousFunds(ctx)
+	ctx = ctx.WithBlockHeight(20)
+	k.PayContinuousFunds(ctx)
+	fund, found := k.GetContinuousFund(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, perPeriod.Add(perPeriod), fund.Paid)
+
+	// the last payout is capped by the total amount, and the fund ends
+	ctx = ctx.WithBlockHeight(30)
+	k.PayContinuousFunds(ctx)
+	_, found = k.GetContinuousFund(ctx, 1)
+	require.False(t, found)
+	require.Equal(t, initial.Add(total), ak.GetAccount(ctx, recipient).GetCoins())
+	require.Equal(t, sdk.NewDecCoins(pool.Sub(total)), k.GetFeePoolCommunityCoins(ctx))
+
+	// a payout the community pool cannot cover is skipped
+	k.SetContinuousFund(ctx, types.NewContinuousFund(2, recipient, pool, 10, nil, ctx.BlockHeader().Time.Add(time.Hour), 30))
+	ctx = ctx.WithBlockHeight(40)
+	k.PayContinuousFunds(ctx)
+	fund, found = k.GetContinuousFund(ctx, 2)
+	require.True(t, found)
+	require.True(t, fund.Paid.Empty())
+
+	// the fund ends at its end time, and is deleted when its next payout is due
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	ctx = ctx.WithBlockHeight(45)
+	k.PayContinuousFunds(ctx)
+	_, found = k.GetContinuousFund(ctx, 2)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(50)
+	k.PayContinuousFunds(ctx)
+	_, found = k.GetContinuousFund(ctx, 2)
+	require.False(t, found)
+	require.Empty(t, k.GetContinuousFunds(ctx))
+
+	// a cancelled fund is dropped from the queue
+	k.SetContinuousFund(ctx, types.NewContinuousFund(3, recipient, perPeriod, 10, nil, ctx.BlockHeader().Time.Add(time.Hour), 50))
+	k.DeleteContinuousFund(ctx, 3)
+	ctx = ctx.WithBlockHeight(60)
+	k.PayContinuousFunds(ctx)
+	require.Empty(t, k.GetContinuousFunds(ctx))
+	require.Empty(t, k.dequeueContinuousFunds(ctx, 60))
+}
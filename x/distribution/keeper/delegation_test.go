@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/distribution/types"
 	"github.com/barkisnet/barkis/x/staking"
 )
 
@@ -618,3 +619,131 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1).IsZero())
 }
+
+func TestWithdrawAllRewards(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create two validators with 50% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	msg = staking.NewMsgCreateValidator(valOpAddr2, valConsPk2,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// the first validator operator also delegates to the second validator
+	delMsg := staking.NewMsgDelegate(sdk.AccAddress(valOpAddr1), valOpAddr2, sdk.NewCoin(sdk.DefaultBondDenom, valTokens))
+	require.True(t, sh(ctx, delMsg).IsOK())
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr2), tokens)
+
+	// withdraw all rewards and commission
+	gasBefore := ctx.GasMeter().GasConsumed()
+	withdrawn, err := k.WithdrawAllRewards(ctx, sdk.AccAddress(valOpAddr1))
+	require.Nil(t, err)
+	require.True(t, ctx.GasMeter().GasConsumed()-gasBefore >= 2*types.WithdrawAllRewardsGasPerDelegation)
+
+	// rewards from both delegations (half of each validator rewards, the
+	// second validator is delegated to equally by both operators) and the
+	// commission of the first validator
+	exp := initial.QuoRaw(2).Add(initial.QuoRaw(4)).Add(initial.QuoRaw(2))
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp)}, withdrawn)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Sub(valTokens.MulRaw(2)).Add(exp))},
+		ak.GetAccount(ctx, sdk.AccAddress(valOpAddr1)).GetCoins(),
+	)
+	require.True(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1).IsZero())
+
+	// nothing left to withdraw
+	withdrawn, err = k.WithdrawAllRewards(ctx, sdk.AccAddress(valOpAddr1))
+	require.Nil(t, err)
+	require.True(t, withdrawn.IsZero())
+}
+
+func TestTransferDelegationWithdrawsRewards(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	power := int64(100)
+	valTokens := sdk.TokensFromConsensusPower(power)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := sk.Validator(ctx, valOpAddr1)
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	k.AllocateTokensToValidator(ctx, val, tokens)
+
+	// transfer half of the self delegation
+	transferMsg := staking.NewMsgTransferDelegation(sdk.AccAddress(valOpAddr1), valOpAddr1, delAddr1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens.QuoRaw(2)))
+	require.True(t, sh(ctx, transferMsg).IsOK())
+
+	// the rewards of the transferred shares have been withdrawn to the previous delegator
+	exp := balanceTokens.Sub(valTokens).Add(initial.QuoRaw(2))
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp)},
+		ak.GetAccount(ctx, sdk.AccAddress(valOpAddr1)).GetCoins(),
+	)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some more rewards, shared by both delegators
+	val = sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, tokens)
+
+	_, err := k.WithdrawDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Add(initial.QuoRaw(4)))},
+		ak.GetAccount(ctx, delAddr1).GetCoins(),
+	)
+
+	_, err = k.WithdrawDelegationRewards(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp.Add(initial.QuoRaw(4)))},
+		ak.GetAccount(ctx, sdk.AccAddress(valOpAddr1)).GetCoins(),
+	)
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/x/distribution/types"
 	"github.com/barkisnet/barkis/x/params"
+	stakingexported "github.com/barkisnet/barkis/x/staking/exported"
 
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -24,6 +25,8 @@ type Keeper struct {
 	blacklistedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	ledger *RewardLedger // node-local reward ledger, nil when disabled
 }
 
 // NewKeeper creates a new distribution Keeper instance
@@ -139,6 +142,45 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	return commission, nil
 }
 
+// withdraw the rewards of all the delegations of a delegator, and the
+// commission if the delegator is a validator operator. Gas is consumed for
+// each delegation and the number of delegations is capped, so the gas used
+// is bounded. The delegations are not walked past the cap.
+func (k Keeper) WithdrawAllRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr,
+		func(_ int64, del stakingexported.DelegationI) (stop bool) {
+			valAddrs = append(valAddrs, del.GetValidatorAddr())
+			return len(valAddrs) > types.MaxWithdrawAllRewardsDelegations
+		},
+	)
+	if len(valAddrs) > types.MaxWithdrawAllRewardsDelegations {
+		return nil, types.ErrTooManyDelegations(k.codespace)
+	}
+
+	var withdrawn sdk.Coins
+	for _, valAddr := range valAddrs {
+		ctx.GasMeter().ConsumeGas(types.WithdrawAllRewardsGasPerDelegation, "withdraw delegation rewards")
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		withdrawn = withdrawn.Add(rewards)
+	}
+
+	valAddr := sdk.ValAddress(delAddr)
+	if k.stakingKeeper.Validator(ctx, valAddr) != nil && !k.GetValidatorAccumulatedCommission(ctx, valAddr).IsZero() {
+		commission, err := k.WithdrawValidatorCommission(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		withdrawn = withdrawn.Add(commission)
+	}
+
+	return withdrawn, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
@@ -32,6 +32,16 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes><valAddr_Bytes>: []byte{0x01}
+//
+// - 0x0A: auto-restake cursor key
+//
+// - 0x0B<fundID_Bytes>: ContinuousFund
+//
+// - 0x0C: next continuous fund ID
+//
+// - 0x0D<height_Bytes><fundID_Bytes>: []byte{0x01}
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -43,11 +53,19 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	AutoRestakePrefix                    = []byte{0x09} // key for delegations opted in to auto-restaking
+	AutoRestakeCursorKey                 = []byte{0x0A} // key for the last auto-restaked delegation of the current round
+	ContinuousFundPrefix                 = []byte{0x0B} // key for continuous funds
+	NextContinuousFundIDKey              = []byte{0x0C} // key for the ID of the next continuous fund
+	ContinuousFundQueuePrefix            = []byte{0x0D} // key for the queue of continuous funds by next payout height
 
 	ParamStoreKeyCommunityTax        = []byte("communitytax")
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoRestakeInterval     = []byte("autorestakeinterval")
+	ParamStoreKeyMaxAutoRestakesPerBlock = []byte("maxautorestakesperblock")
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -191,3 +209,48 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the addresses from an auto-restake key
+func GetAutoRestakeAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
+// gets the prefix of the auto-restaked delegations of a delegator
+func GetDelegatorAutoRestakesPrefix(d sdk.AccAddress) []byte {
+	return append(AutoRestakePrefix, d.Bytes()...)
+}
+
+// gets the key of an auto-restaked delegation
+func GetAutoRestakeKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoRestakesPrefix(d), v.Bytes()...)
+}
+
+// gets the key for a continuous fund
+func GetContinuousFundKey(id uint64) []byte {
+	return append(ContinuousFundPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the prefix of the continuous funds queued for a payout at a height
+func GetContinuousFundQueueHeightPrefix(height int64) []byte {
+	return append(ContinuousFundQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// gets the key of a continuous fund queued for a payout at a height
+func GetContinuousFundQueueKey(height int64, id uint64) []byte {
+	return append(GetContinuousFundQueueHeightPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the fund ID from a continuous fund queue key
+func GetContinuousFundQueueFundID(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[1+8:])
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/distribution/types"
 	"github.com/barkisnet/barkis/x/params"
 )
 
@@ -12,7 +15,32 @@ func ParamKeyTable() params.KeyTable {
 		ParamStoreKeyBaseProposerReward, sdk.Dec{},
 		ParamStoreKeyBonusProposerReward, sdk.Dec{},
 		ParamStoreKeyWithdrawAddrEnabled, false,
-	)
+	).RegisterType(ParamStoreKeyAutoRestakeInterval, int64(0)).
+		RegisterValidator(ParamStoreKeyAutoRestakeInterval, validateAutoRestakeInterval).
+		RegisterType(ParamStoreKeyMaxAutoRestakesPerBlock, uint16(0)).
+		RegisterValidator(ParamStoreKeyMaxAutoRestakesPerBlock, validateMaxAutoRestakesPerBlock)
+}
+
+func validateAutoRestakeInterval(_ sdk.Context, i interface{}) error {
+	interval, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid auto-restake interval type: %T", i)
+	}
+	if interval < 0 {
+		return fmt.Errorf("auto-restake interval cannot be negative: %d", interval)
+	}
+	return nil
+}
+
+func validateMaxAutoRestakesPerBlock(_ sdk.Context, i interface{}) error {
+	max, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid max auto-restakes per block type: %T", i)
+	}
+	if max == 0 {
+		return fmt.Errorf("max auto-restakes per block must be positive")
+	}
+	return nil
 }
 
 // returns the current CommunityTax rate from the global param store
@@ -66,3 +94,27 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
 func (k Keeper) SetWithdrawAddrEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled)
 }
+
+// returns the number of blocks between two auto-restaking rounds
+func (k Keeper) GetAutoRestakeInterval(ctx sdk.Context) int64 {
+	interval := types.DefaultAutoRestakeInterval
+	k.paramSpace.GetIfExists(ctx, ParamStoreKeyAutoRestakeInterval, &interval)
+	return interval
+}
+
+// nolint: errcheck
+func (k Keeper) SetAutoRestakeInterval(ctx sdk.Context, interval int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyAutoRestakeInterval, &interval)
+}
+
+// returns the maximum number of delegations auto-restaked in a block
+func (k Keeper) GetMaxAutoRestakesPerBlock(ctx sdk.Context) uint16 {
+	max := types.DefaultMaxAutoRestakesPerBlock
+	k.paramSpace.GetIfExists(ctx, ParamStoreKeyMaxAutoRestakesPerBlock, &max)
+	return max
+}
+
+// nolint: errcheck
+func (k Keeper) SetMaxAutoRestakesPerBlock(ctx sdk.Context, max uint16) {
+	k.paramSpace.Set(ctx, ParamStoreKeyMaxAutoRestakesPerBlock, &max)
+}
@@ -22,3 +22,59 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p types.Communi
 	logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
 }
+
+// HandleContinuousFundProposal is a handler for executing a passed continuous fund proposal
+func HandleContinuousFundProposal(ctx sdk.Context, k Keeper, p types.ContinuousFundProposal) sdk.Error {
+	if !sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.ContinuousFundUpgrade) {
+		return sdk.ErrUnknownRequest("continuous fund proposals are not enabled yet")
+	}
+	if k.blacklistedAddrs[p.Recipient.String()] {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is blacklisted from receiving external funds", p.Recipient))
+	}
+	if !p.EndTime.IsZero() && !p.EndTime.After(ctx.BlockHeader().Time) {
+		return types.ErrInvalidContinuousFund(k.codespace, "end time must be in the future")
+	}
+
+	id := k.GetNextContinuousFundID(ctx)
+	fund := types.NewContinuousFund(id, p.Recipient, p.AmountPerPeriod, p.Period, p.TotalAmount, p.EndTime, ctx.BlockHeight())
+	k.SetContinuousFund(ctx, fund)
+	k.SetNextContinuousFundID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyFundID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, p.Recipient.String()),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("started continuous fund %d of %s every %d blocks to recipient %s", id, p.AmountPerPeriod, p.Period, p.Recipient))
+	return nil
+}
+
+// HandleCancelContinuousFundProposal is a handler for executing a passed cancel continuous fund proposal
+func HandleCancelContinuousFundProposal(ctx sdk.Context, k Keeper, p types.CancelContinuousFundProposal) sdk.Error {
+	if !sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.ContinuousFundUpgrade) {
+		return sdk.ErrUnknownRequest("continuous fund proposals are not enabled yet")
+	}
+
+	fund, found := k.GetContinuousFund(ctx, p.FundID)
+	if !found {
+		return types.ErrUnknownContinuousFund(k.codespace, p.FundID)
+	}
+	k.DeleteContinuousFund(ctx, fund.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundCancelled,
+			sdk.NewAttribute(types.AttributeKeyFundID, fmt.Sprintf("%d", fund.ID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fund.Paid.String()),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled continuous fund %d after paying %s", fund.ID, fund.Paid))
+	return nil
+}
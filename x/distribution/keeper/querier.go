@@ -42,6 +42,21 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryDelegatorAutoRestakes:
+			return queryDelegatorAutoRestakes(ctx, path[1:], req, k)
+
+		case types.QueryValidatorRewardHistory:
+			return queryValidatorRewardHistory(ctx, path[1:], req, k)
+
+		case types.QueryCommunityPoolHistory:
+			return queryCommunityPoolHistory(ctx, path[1:], req, k)
+
+		case types.QueryContinuousFunds:
+			return queryContinuousFunds(ctx, path[1:], req, k)
+
+		case types.QueryContinuousFund:
+			return queryContinuousFund(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
 		}
@@ -248,3 +263,113 @@ func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Ke
 	}
 	return bz, nil
 }
+
+func queryDelegatorAutoRestakes(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	autoRestakes := make([]types.AutoRestakeRecord, 0)
+	k.IterateDelegatorAutoRestakes(ctx, params.DelegatorAddress,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			autoRestakes = append(autoRestakes, types.NewAutoRestakeRecord(del, val))
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, autoRestakes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// normalize the height range and limit of a reward history query, which
+// default to all the heights up to the current one and to
+// DefaultRewardHistoryLimit records
+func rewardHistoryRange(ctx sdk.Context, startHeight, endHeight int64, limit int) (int64, int64, int) {
+	if startHeight < 0 {
+		startHeight = 0
+	}
+	if endHeight <= 0 || endHeight > ctx.BlockHeight() {
+		endHeight = ctx.BlockHeight()
+	}
+	if limit <= 0 {
+		limit = types.DefaultRewardHistoryLimit
+	}
+	if limit > types.MaxRewardHistoryLimit {
+		limit = types.MaxRewardHistoryLimit
+	}
+	return startHeight, endHeight, limit
+}
+
+func queryValidatorRewardHistory(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if !k.RewardLedgerEnabled() {
+		return nil, types.ErrRewardLedgerDisabled(k.codespace)
+	}
+
+	var params types.QueryValidatorRewardHistoryParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	start, end, limit := rewardHistoryRange(ctx, params.StartHeight, params.EndHeight, params.Limit)
+	records := k.GetValidatorRewardHistory(params.ValidatorAddress, start, end, limit)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryCommunityPoolHistory(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if !k.RewardLedgerEnabled() {
+		return nil, types.ErrRewardLedgerDisabled(k.codespace)
+	}
+
+	var params types.QueryCommunityPoolHistoryParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	start, end, limit := rewardHistoryRange(ctx, params.StartHeight, params.EndHeight, params.Limit)
+	records := k.GetCommunityPoolHistory(start, end, limit)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryContinuousFunds(ctx sdk.Context, _ []string, _ abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetContinuousFunds(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryContinuousFund(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryContinuousFundParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	fund, found := k.GetContinuousFund(ctx, params.FundID)
+	if !found {
+		return nil, types.ErrUnknownContinuousFund(k.codespace, params.FundID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, fund)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/distribution/types"
+)
+
+// Keys for the reward ledger database
+//
+// - 0x01<valAddr_Bytes><height_Bytes>: ValidatorRewardRecord
+//
+// - 0x02<height_Bytes>: CommunityPoolRecord
+//
+// - 0x03<height_Bytes>: sdk.DecCoins, block rewards allocated to the community pool in a block not recorded yet
+var (
+	ledgerValidatorRecordPrefix         = []byte{0x01}
+	ledgerCommunityPoolRecordPrefix     = []byte{0x02}
+	ledgerCommunityPoolAllocationPrefix = []byte{0x03}
+)
+
+// RewardLedger is a node-local index of the commission and outstanding rewards
+// of validators and of the community pool with the block rewards allocated to
+// it, recorded every interval blocks. It is kept out of the application state, so each node chooses
+// whether to index it or not.
+type RewardLedger struct {
+	db       dbm.DB
+	interval int64
+}
+
+// NewRewardLedger creates a reward ledger recording every interval blocks
+func NewRewardLedger(db dbm.DB, interval int64) *RewardLedger {
+	if interval <= 0 {
+		panic("reward ledger interval must be positive")
+	}
+	return &RewardLedger{
+		db:       db,
+		interval: interval,
+	}
+}
+
+func heightBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
+func getLedgerValidatorRecordKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(append(ledgerValidatorRecordPrefix, valAddr.Bytes()...), heightBytes(height)...)
+}
+
+func getLedgerCommunityPoolRecordKey(height int64) []byte {
+	return append(ledgerCommunityPoolRecordPrefix, heightBytes(height)...)
+}
+
+func getLedgerCommunityPoolAllocationKey(height int64) []byte {
+	return append(ledgerCommunityPoolAllocationPrefix, heightBytes(height)...)
+}
+
+// SetRewardLedger enables the reward ledger. It must be called before the
+// keeper is passed to the other modules.
+func (k *Keeper) SetRewardLedger(ledger *RewardLedger) *Keeper {
+	if k.ledger != nil {
+		panic("cannot set reward ledger twice")
+	}
+	k.ledger = ledger
+	return k
+}
+
+// check whether the reward ledger is enabled on this node
+func (k Keeper) RewardLedgerEnabled() bool {
+	return k.ledger != nil
+}
+
+// record the block rewards allocated to the community pool in the current
+// block. It is keyed by height, so replaying a block overwrites it.
+func (k Keeper) recordCommunityPoolAllocation(ctx sdk.Context, allocated sdk.DecCoins) {
+	if k.ledger == nil || allocated.IsZero() {
+		return
+	}
+	k.ledger.db.Set(getLedgerCommunityPoolAllocationKey(ctx.BlockHeight()), k.cdc.MustMarshalBinaryLengthPrefixed(allocated))
+}
+
+// RecordRewardLedger records the commission and outstanding rewards of all the
+// validators, and the community pool, when the height is a multiple of the
+// reward ledger interval. Every entry is keyed by height and overwritten, so
+// that replaying a block after a crash records the same entries again.
+func (k Keeper) RecordRewardLedger(ctx sdk.Context) {
+	if k.ledger == nil || ctx.BlockHeight()%k.ledger.interval != 0 {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	batch := k.ledger.db.NewBatch()
+	defer batch.Close()
+
+	k.IterateValidatorOutstandingRewards(ctx,
+		func(valAddr sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+			record := types.NewValidatorRewardRecord(height, valAddr,
+				k.GetValidatorAccumulatedCommission(ctx, valAddr), rewards)
+			batch.Set(getLedgerValidatorRecordKey(valAddr, height), k.cdc.MustMarshalBinaryLengthPrefixed(record))
+			return false
+		},
+	)
+
+	// sum up the allocations of the blocks since the previous record. They are
+	// kept until the next record so that a replay sums up the same blocks.
+	start := height - k.ledger.interval + 1
+	var allocated sdk.DecCoins
+	iter := k.ledger.db.Iterator(getLedgerCommunityPoolAllocationKey(start), getLedgerCommunityPoolAllocationKey(height+1))
+	for ; iter.Valid(); iter.Next() {
+		var blockAllocated sdk.DecCoins
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &blockAllocated)
+		allocated = allocated.Add(blockAllocated)
+	}
+	iter.Close()
+
+	// prune the allocations of the previous records
+	iter = k.ledger.db.Iterator(ledgerCommunityPoolAllocationPrefix, getLedgerCommunityPoolAllocationKey(start))
+	for ; iter.Valid(); iter.Next() {
+		batch.Delete(iter.Key())
+	}
+	iter.Close()
+
+	record := types.NewCommunityPoolRecord(height, allocated, k.GetFeePoolCommunityCoins(ctx))
+	batch.Set(getLedgerCommunityPoolRecordKey(height), k.cdc.MustMarshalBinaryLengthPrefixed(record))
+
+	batch.Write()
+}
+
+// get the recorded rewards of a validator between two heights, both included
+func (k Keeper) GetValidatorRewardHistory(valAddr sdk.ValAddress, startHeight, endHeight int64,
+	limit int) (records types.ValidatorRewardRecords) {
+
+	records = types.ValidatorRewardRecords{}
+	if k.ledger == nil {
+		return records
+	}
+
+	iter := k.ledger.db.Iterator(
+		getLedgerValidatorRecordKey(valAddr, startHeight),
+		getLedgerValidatorRecordKey(valAddr, endHeight+1),
+	)
+	defer iter.Close()
+	for ; iter.Valid() && len(records) < limit; iter.Next() {
+		var record types.ValidatorRewardRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// get the recorded community pool between two heights, both included
+func (k Keeper) GetCommunityPoolHistory(startHeight, endHeight int64, limit int) (records types.CommunityPoolRecords) {
+	records = types.CommunityPoolRecords{}
+	if k.ledger == nil {
+		return records
+	}
+
+	iter := k.ledger.db.Iterator(
+		getLedgerCommunityPoolRecordKey(startHeight),
+		getLedgerCommunityPoolRecordKey(endHeight+1),
+	)
+	defer iter.Close()
+	for ; iter.Valid() && len(records) < limit; iter.Next() {
+		var record types.CommunityPoolRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/staking"
+)
+
+func TestRewardLedger(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// disabled by default
+	require.False(t, k.RewardLedgerEnabled())
+	require.Empty(t, k.GetCommunityPoolHistory(0, 100, 10))
+
+	k.SetRewardLedger(NewRewardLedger(dbm.NewMemDB(), 2))
+	require.True(t, k.RewardLedgerEnabled())
+
+	// create validator with 50% commission
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// allocate fees over a few blocks
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	votes := []abci.VoteInfo{{Validator: abci.Validator{Address: valConsPk1.Address(), Power: 100}, SignedLastBlock: true}}
+	for height := int64(1); height <= 4; height++ {
+		ctx = ctx.WithBlockHeight(height)
+
+		feeCollector := k.supplyKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+		require.Nil(t, feeCollector.SetCoins(fees))
+		k.supplyKeeper.SetModuleAccount(ctx, feeCollector)
+
+		k.AllocateTokens(ctx, 100, 100, valConsPk1.Address().Bytes(), votes)
+		k.RecordRewardLedger(ctx)
+	}
+
+	// validator recorded every two blocks, with accumulating rewards
+	records := k.GetValidatorRewardHistory(valOpAddr1, 0, 4, 10)
+	require.Len(t, records, 2)
+	require.Equal(t, int64(2), records[0].Height)
+	require.Equal(t, int64(4), records[1].Height)
+	require.Equal(t, valOpAddr1, records[1].ValidatorAddress)
+	require.Equal(t, records[0].OutstandingRewards.MulDec(sdk.NewDec(2)), records[1].OutstandingRewards)
+	require.Equal(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1), records[1].Commission)
+
+	// the range and the limit are respected
+	records = k.GetValidatorRewardHistory(valOpAddr1, 3, 4, 10)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(4), records[0].Height)
+	records = k.GetValidatorRewardHistory(valOpAddr1, 0, 4, 1)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(2), records[0].Height)
+	require.Empty(t, k.GetValidatorRewardHistory(valOpAddr2, 0, 4, 10))
+
+	// community pool allocations summed up between records, 2% community tax of two blocks
+	poolRecords := k.GetCommunityPoolHistory(0, 4, 10)
+	require.Len(t, poolRecords, 2)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(4))}, poolRecords[1].Allocated)
+	require.Equal(t, k.GetFeePoolCommunityCoins(ctx), poolRecords[1].CommunityPool)
+
+	// replaying the last block records the same entries, without duplicates
+	k.recordCommunityPoolAllocation(ctx, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(2))})
+	k.RecordRewardLedger(ctx)
+	require.Equal(t, poolRecords, k.GetCommunityPoolHistory(0, 4, 10))
+	require.Len(t, k.GetValidatorRewardHistory(valOpAddr1, 0, 4, 10), 2)
+}
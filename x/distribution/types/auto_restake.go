@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// default auto-restaking parameters
+const (
+	// DefaultAutoRestakeInterval is the number of blocks between two rounds
+	// of auto-restaking
+	DefaultAutoRestakeInterval int64 = 600
+
+	// DefaultMaxAutoRestakesPerBlock bounds the delegations auto-restaked in a
+	// block; a round spans several blocks when more delegations opted in
+	DefaultMaxAutoRestakesPerBlock uint16 = 100
+)
+
+// AutoRestakeRecord is a delegation opted in to auto-restaking its rewards,
+// used for queries and import / export via genesis json
+type AutoRestakeRecord struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// NewAutoRestakeRecord creates a new AutoRestakeRecord instance
+func NewAutoRestakeRecord(delAddr sdk.AccAddress, valAddr sdk.ValAddress) AutoRestakeRecord {
+	return AutoRestakeRecord{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+	}
+}
+
+// String implements the stringer interface.
+func (ar AutoRestakeRecord) String() string {
+	return fmt.Sprintf("%s -> %s", ar.DelegatorAddress, ar.ValidatorAddress)
+}
+
+// AutoRestakeRecords is a collection of AutoRestakeRecord
+type AutoRestakeRecords []AutoRestakeRecord
+
+// String implements the stringer interface.
+func (ars AutoRestakeRecords) String() string {
+	out := "Auto-Restaked Delegations:\n"
+	for _, ar := range ars {
+		out += fmt.Sprintf("  %s\n", ar)
+	}
+	return strings.TrimSpace(out)
+}
@@ -9,7 +9,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdrawDelegatorReward{}, "cosmos-sdk/MsgWithdrawDelegationReward", nil)
 	cdc.RegisterConcrete(MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValidatorCommission", nil)
 	cdc.RegisterConcrete(MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(MsgSetAutoRestake{}, "cosmos-sdk/MsgSetAutoRestake", nil)
+	cdc.RegisterConcrete(MsgWithdrawAllRewards{}, "cosmos-sdk/MsgWithdrawAllRewards", nil)
 	cdc.RegisterConcrete(CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
+	cdc.RegisterConcrete(ContinuousFundProposal{}, "cosmos-sdk/ContinuousFundProposal", nil)
+	cdc.RegisterConcrete(CancelContinuousFundProposal{}, "cosmos-sdk/CancelContinuousFundProposal", nil)
 }
 
 // generic sealed codec to be used throughout module
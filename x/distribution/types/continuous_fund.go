@@ -0,0 +1,132 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// ContinuousFund releases AmountPerPeriod from the community pool to the
+// recipient every Period blocks, until TotalAmount has been paid or EndTime
+// is reached. An empty TotalAmount or a zero EndTime sets no such limit, but
+// at least one of them is set.
+type ContinuousFund struct {
+	ID              uint64         `json:"id" yaml:"id"`
+	Recipient       sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	AmountPerPeriod sdk.Coins      `json:"amount_per_period" yaml:"amount_per_period"`
+	Period          int64          `json:"period" yaml:"period"`
+	TotalAmount     sdk.Coins      `json:"total_amount" yaml:"total_amount"`
+	EndTime         time.Time      `json:"end_time" yaml:"end_time"`
+	StartHeight     int64          `json:"start_height" yaml:"start_height"`
+	Paid            sdk.Coins      `json:"paid" yaml:"paid"`
+}
+
+// NewContinuousFund creates a new ContinuousFund instance
+func NewContinuousFund(id uint64, recipient sdk.AccAddress, amountPerPeriod sdk.Coins, period int64,
+	totalAmount sdk.Coins, endTime time.Time, startHeight int64) ContinuousFund {
+
+	return ContinuousFund{
+		ID:              id,
+		Recipient:       recipient,
+		AmountPerPeriod: amountPerPeriod,
+		Period:          period,
+		TotalAmount:     totalAmount,
+		EndTime:         endTime,
+		StartHeight:     startHeight,
+		Paid:            sdk.Coins{},
+	}
+}
+
+// IsPayoutHeight returns true if a payout is due at the given height
+func (cf ContinuousFund) IsPayoutHeight(height int64) bool {
+	return height > cf.StartHeight && (height-cf.StartHeight)%cf.Period == 0
+}
+
+// NextPayoutHeight returns the first height after the given one at which a
+// payout is due
+func (cf ContinuousFund) NextPayoutHeight(height int64) int64 {
+	if height < cf.StartHeight {
+		return cf.StartHeight + cf.Period
+	}
+	return height + cf.Period - (height-cf.StartHeight)%cf.Period
+}
+
+// IsEnded returns true if the fund has paid its total amount or reached its end time
+func (cf ContinuousFund) IsEnded(blockTime time.Time) bool {
+	if !cf.EndTime.IsZero() && !blockTime.Before(cf.EndTime) {
+		return true
+	}
+	return !cf.TotalAmount.Empty() && cf.Paid.IsAllGTE(cf.TotalAmount)
+}
+
+// NextPayout returns the amount of the next payout, which is capped by what
+// is left of the total amount
+func (cf ContinuousFund) NextPayout() sdk.Coins {
+	if cf.TotalAmount.Empty() {
+		return cf.AmountPerPeriod
+	}
+
+	var payout sdk.Coins
+	for _, coin := range cf.AmountPerPeriod {
+		left := cf.TotalAmount.AmountOf(coin.Denom).Sub(cf.Paid.AmountOf(coin.Denom))
+		if left.IsPositive() {
+			payout = payout.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, left))))
+		}
+	}
+	return payout
+}
+
+// ValidateContinuousFund performs stateless validation of the terms of a
+// continuous fund
+func ValidateContinuousFund(recipient sdk.AccAddress, amountPerPeriod sdk.Coins, period int64,
+	totalAmount sdk.Coins) sdk.Error {
+
+	if recipient.Empty() {
+		return ErrEmptyProposalRecipient(DefaultCodespace)
+	}
+	if !amountPerPeriod.IsValid() || amountPerPeriod.Empty() {
+		return ErrInvalidContinuousFund(DefaultCodespace, "amount per period must be positive")
+	}
+	if period <= 0 {
+		return ErrInvalidContinuousFund(DefaultCodespace, "period must be positive")
+	}
+	if !totalAmount.IsValid() {
+		return ErrInvalidContinuousFund(DefaultCodespace, "invalid total amount: "+totalAmount.String())
+	}
+	// a fund could never be paid off with a denom it does not pay out every period
+	if !totalAmount.DenomsSubsetOf(amountPerPeriod) {
+		return ErrInvalidContinuousFund(DefaultCodespace, "total amount denoms must be paid per period")
+	}
+	return nil
+}
+
+// String implements the stringer interface.
+func (cf ContinuousFund) String() string {
+	return fmt.Sprintf(`Continuous Fund %d:
+  Recipient:         %s
+  Amount Per Period: %s
+  Period:            %d blocks
+  Total Amount:      %s
+  End Time:          %s
+  Start Height:      %d
+  Paid:              %s`, cf.ID, cf.Recipient, cf.AmountPerPeriod, cf.Period,
+		cf.TotalAmount, cf.EndTime, cf.StartHeight, cf.Paid)
+}
+
+// ContinuousFunds is a collection of ContinuousFund
+type ContinuousFunds []ContinuousFund
+
+// String implements the stringer interface.
+func (cfs ContinuousFunds) String() string {
+	if len(cfs) == 0 {
+		return "[]"
+	}
+
+	strs := make([]string, len(cfs))
+	for i, cf := range cfs {
+		strs[i] = cf.String()
+	}
+	return strings.Join(strs, "\n")
+}
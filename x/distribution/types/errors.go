@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/barkisnet/barkis/types"
 )
 
@@ -13,6 +15,9 @@ const (
 	CodeNoDistributionInfo      CodeType          = 104
 	CodeNoValidatorCommission   CodeType          = 105
 	CodeSetWithdrawAddrDisabled CodeType          = 106
+	CodeTooManyDelegations      CodeType          = 107
+	CodeRewardLedgerDisabled    CodeType          = 108
+	CodeUnknownContinuousFund   CodeType          = 109
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -36,6 +41,19 @@ func ErrNoValidatorCommission(codespace sdk.CodespaceType) sdk.Error {
 func ErrSetWithdrawAddrDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSetWithdrawAddrDisabled, "set withdraw address disabled")
 }
+func ErrTooManyDelegations(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTooManyDelegations,
+		fmt.Sprintf("more than %d delegations to withdraw at once, withdraw from each validator instead", MaxWithdrawAllRewardsDelegations))
+}
+func ErrRewardLedgerDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRewardLedgerDisabled, "reward ledger is disabled on this node")
+}
+func ErrInvalidContinuousFund(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "invalid continuous fund: "+msg)
+}
+func ErrUnknownContinuousFund(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownContinuousFund, fmt.Sprintf("unknown continuous fund %d", id))
+}
 func ErrBadDistribution(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "community pool does not have sufficient coins to distribute")
 }
@@ -8,9 +8,19 @@ var (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoRestake     = "set_auto_restake"
+	EventTypeAutoRestake        = "auto_restake"
+	EventTypeContinuousFund     = "continuous_fund"
+	EventTypeFundPayout         = "continuous_fund_payout"
+	EventTypeFundEnded          = "continuous_fund_ended"
+	EventTypeFundCancelled      = "continuous_fund_cancelled"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyFundID          = "fund_id"
+	AttributeKeyRecipient       = "recipient"
 
 	AttributeValueCategory = ModuleName
 )
@@ -68,13 +68,17 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	AutoRestakes                    []AutoRestakeRecord                    `json:"auto_restakes" yaml:"auto_restakes"`
+	ContinuousFunds                 []ContinuousFund                       `json:"continuous_funds" yaml:"continuous_funds"`
+	NextContinuousFundID            uint64                                 `json:"next_continuous_fund_id" yaml:"next_continuous_fund_id"`
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
 	withdrawAddrEnabled bool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord,
-	slashes []ValidatorSlashEventRecord) GenesisState {
+	slashes []ValidatorSlashEventRecord, autoRestakes []AutoRestakeRecord, funds []ContinuousFund,
+	nextFundID uint64) GenesisState {
 
 	return GenesisState{
 		FeePool:                         feePool,
@@ -90,6 +94,9 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoRestakes:                    autoRestakes,
+		ContinuousFunds:                 funds,
+		NextContinuousFundID:            nextFundID,
 	}
 }
 
@@ -109,6 +116,9 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoRestakes:                    []AutoRestakeRecord{},
+		ContinuousFunds:                 []ContinuousFund{},
+		NextContinuousFundID:            1,
 	}
 }
 
@@ -132,5 +142,13 @@ func ValidateGenesis(data GenesisState) error {
 			"BonusProposerReward cannot add to be greater than one, "+
 			"adds to %s", data.BaseProposerReward.Add(data.BonusProposerReward).String())
 	}
+	for _, fund := range data.ContinuousFunds {
+		if err := ValidateContinuousFund(fund.Recipient, fund.AmountPerPeriod, fund.Period, fund.TotalAmount); err != nil {
+			return fmt.Errorf("invalid continuous fund %d: %s", fund.ID, err.Result().Log)
+		}
+		if fund.ID >= data.NextContinuousFundID {
+			return fmt.Errorf("continuous fund %d is not below the next continuous fund ID %d", fund.ID, data.NextContinuousFundID)
+		}
+	}
 	return data.FeePool.ValidateGenesis()
 }
@@ -6,7 +6,7 @@ import (
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgSetAutoRestake{}, &MsgWithdrawAllRewards{}
 
 // msg struct for changing the withdraw address for a delegator (or validator self-delegation)
 type MsgSetWithdrawAddress struct {
@@ -116,3 +116,88 @@ func (msg MsgWithdrawValidatorCommission) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// bounds on the gas used by MsgWithdrawAllRewards
+const (
+	// WithdrawAllRewardsGasPerDelegation is the gas consumed for each
+	// delegation withdrawn from
+	WithdrawAllRewardsGasPerDelegation uint64 = 10000
+
+	// MaxWithdrawAllRewardsDelegations is the maximum number of delegations
+	// withdrawn from in a single message
+	MaxWithdrawAllRewardsDelegations = 100
+)
+
+// msg struct for withdrawing the rewards of all the delegations of a delegator,
+// and the commission if the delegator is a validator operator
+type MsgWithdrawAllRewards struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+}
+
+func NewMsgWithdrawAllRewards(delAddr sdk.AccAddress) MsgWithdrawAllRewards {
+	return MsgWithdrawAllRewards{
+		DelegatorAddress: delAddr,
+	}
+}
+
+func (msg MsgWithdrawAllRewards) Route() string { return ModuleName }
+func (msg MsgWithdrawAllRewards) Type() string  { return "withdraw_all_rewards" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawAllRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.DelegatorAddress)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawAllRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawAllRewards) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+// msg struct for opting a delegation in or out of auto-restaking its rewards
+type MsgSetAutoRestake struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Enabled          bool           `json:"enabled" yaml:"enabled"`
+}
+
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) MsgSetAutoRestake {
+	return MsgSetAutoRestake{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoRestake) Route() string { return ModuleName }
+func (msg MsgSetAutoRestake) Type() string  { return "set_auto_restake" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.DelegatorAddress)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoRestake) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/barkisnet/barkis/types"
 	govtypes "github.com/barkisnet/barkis/x/gov/types"
@@ -11,14 +12,28 @@ import (
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeContinuousFund defines the type for a ContinuousFundProposal
+	ProposalTypeContinuousFund = "ContinuousFund"
+	// ProposalTypeCancelContinuousFund defines the type for a CancelContinuousFundProposal
+	ProposalTypeCancelContinuousFund = "CancelContinuousFund"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = CommunityPoolSpendProposal{}
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = CommunityPoolSpendProposal{}
+	_ govtypes.Content = ContinuousFundProposal{}
+	_ govtypes.Content = CancelContinuousFundProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeContinuousFund)
+	govtypes.RegisterProposalTypeUpgrade(ProposalTypeContinuousFund, sdk.ContinuousFundUpgrade)
+	govtypes.RegisterProposalTypeCodec(ContinuousFundProposal{}, "cosmos-sdk/ContinuousFundProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelContinuousFund)
+	govtypes.RegisterProposalTypeUpgrade(ProposalTypeCancelContinuousFund, sdk.ContinuousFundUpgrade)
+	govtypes.RegisterProposalTypeCodec(CancelContinuousFundProposal{}, "cosmos-sdk/CancelContinuousFundProposal")
 }
 
 // CommunityPoolSpendProposal spends from the community pool
@@ -72,3 +87,107 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// ContinuousFundProposal starts a continuous fund releasing an amount from
+// the community pool to a recipient every period of blocks, until the total
+// amount has been paid or the end time is reached
+type ContinuousFundProposal struct {
+	Title           string         `json:"title" yaml:"title"`
+	Description     string         `json:"description" yaml:"description"`
+	Recipient       sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	AmountPerPeriod sdk.Coins      `json:"amount_per_period" yaml:"amount_per_period"`
+	Period          int64          `json:"period" yaml:"period"`
+	TotalAmount     sdk.Coins      `json:"total_amount" yaml:"total_amount"`
+	EndTime         time.Time      `json:"end_time" yaml:"end_time"`
+}
+
+// NewContinuousFundProposal creates a new continuous fund proposal.
+func NewContinuousFundProposal(title, description string, recipient sdk.AccAddress, amountPerPeriod sdk.Coins,
+	period int64, totalAmount sdk.Coins, endTime time.Time) ContinuousFundProposal {
+
+	return ContinuousFundProposal{title, description, recipient, amountPerPeriod, period, totalAmount, endTime}
+}
+
+// GetTitle returns the title of a continuous fund proposal.
+func (cfp ContinuousFundProposal) GetTitle() string { return cfp.Title }
+
+// GetDescription returns the description of a continuous fund proposal.
+func (cfp ContinuousFundProposal) GetDescription() string { return cfp.Description }
+
+// ProposalRoute returns the routing key of a continuous fund proposal.
+func (cfp ContinuousFundProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a continuous fund proposal.
+func (cfp ContinuousFundProposal) ProposalType() string { return ProposalTypeContinuousFund }
+
+// ValidateBasic runs basic stateless validity checks
+func (cfp ContinuousFundProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, cfp)
+	if err != nil {
+		return err
+	}
+	if err := ValidateContinuousFund(cfp.Recipient, cfp.AmountPerPeriod, cfp.Period, cfp.TotalAmount); err != nil {
+		return err
+	}
+	if cfp.TotalAmount.Empty() && cfp.EndTime.IsZero() {
+		return ErrInvalidContinuousFund(DefaultCodespace, "either a total amount or an end time must be set")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cfp ContinuousFundProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Continuous Fund Proposal:
+  Title:             %s
+  Description:       %s
+  Recipient:         %s
+  Amount Per Period: %s
+  Period:            %d blocks
+  Total Amount:      %s
+  End Time:          %s
+`, cfp.Title, cfp.Description, cfp.Recipient, cfp.AmountPerPeriod, cfp.Period, cfp.TotalAmount, cfp.EndTime))
+	return b.String()
+}
+
+// CancelContinuousFundProposal cancels a continuous fund
+type CancelContinuousFundProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	FundID      uint64 `json:"fund_id" yaml:"fund_id"`
+}
+
+// NewCancelContinuousFundProposal creates a new cancel continuous fund proposal.
+func NewCancelContinuousFundProposal(title, description string, fundID uint64) CancelContinuousFundProposal {
+	return CancelContinuousFundProposal{title, description, fundID}
+}
+
+// GetTitle returns the title of a cancel continuous fund proposal.
+func (ccp CancelContinuousFundProposal) GetTitle() string { return ccp.Title }
+
+// GetDescription returns the description of a cancel continuous fund proposal.
+func (ccp CancelContinuousFundProposal) GetDescription() string { return ccp.Description }
+
+// ProposalRoute returns the routing key of a cancel continuous fund proposal.
+func (ccp CancelContinuousFundProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel continuous fund proposal.
+func (ccp CancelContinuousFundProposal) ProposalType() string {
+	return ProposalTypeCancelContinuousFund
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp CancelContinuousFundProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(DefaultCodespace, ccp)
+}
+
+// String implements the Stringer interface.
+func (ccp CancelContinuousFundProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Continuous Fund Proposal:
+  Title:       %s
+  Description: %s
+  Fund ID:     %d
+`, ccp.Title, ccp.Description, ccp.FundID))
+	return b.String()
+}
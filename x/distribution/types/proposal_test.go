@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// test ValidateBasic for ContinuousFundProposal
+func TestContinuousFundProposal(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10)))
+	otherAmount := amount.Add(sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(10))))
+	endTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		recipient       sdk.AccAddress
+		amountPerPeriod sdk.Coins
+		period          int64
+		totalAmount     sdk.Coins
+		endTime         time.Time
+		expectPass      bool
+	}{
+		{delAddr1, amount, 10, amount, time.Time{}, true},
+		{delAddr1, amount, 10, nil, endTime, true},
+		{delAddr1, amount, 10, amount, endTime, true},
+		{delAddr1, amount, 10, nil, time.Time{}, false},
+		{emptyDelAddr, amount, 10, amount, endTime, false},
+		{delAddr1, nil, 10, amount, endTime, false},
+		{delAddr1, amount, 0, amount, endTime, false},
+		{delAddr1, amount, -1, amount, endTime, false},
+		{delAddr1, otherAmount, 10, amount, time.Time{}, true},
+		{delAddr1, amount, 10, otherAmount, time.Time{}, false},
+	}
+
+	for i, tc := range tests {
+		p := NewContinuousFundProposal("title", "description", tc.recipient, tc.amountPerPeriod,
+			tc.period, tc.totalAmount, tc.endTime)
+		if tc.expectPass {
+			require.Nil(t, p.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, p.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestContinuousFundNextPayout(t *testing.T) {
+	perPeriod := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(40)), sdk.NewCoin("token", sdk.NewInt(10)))
+	total := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+
+	fund := NewContinuousFund(1, delAddr1, perPeriod, 10, total, time.Time{}, 5)
+	require.False(t, fund.IsPayoutHeight(5))
+	require.True(t, fund.IsPayoutHeight(15))
+	require.False(t, fund.IsPayoutHeight(20))
+	require.Equal(t, int64(15), fund.NextPayoutHeight(0))
+	require.Equal(t, int64(15), fund.NextPayoutHeight(5))
+	require.Equal(t, int64(25), fund.NextPayoutHeight(15))
+	require.Equal(t, int64(25), fund.NextPayoutHeight(20))
+
+	// denoms missing from the total amount are not paid
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(40))), fund.NextPayout())
+
+	fund.Paid = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(80)))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(20))), fund.NextPayout())
+	require.False(t, fund.IsEnded(time.Now()))
+
+	fund.Paid = total
+	require.True(t, fund.IsEnded(time.Now()))
+}
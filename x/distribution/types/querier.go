@@ -13,6 +13,11 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryDelegatorAutoRestakes       = "delegator_auto_restakes"
+	QueryValidatorRewardHistory      = "validator_reward_history"
+	QueryCommunityPoolHistory        = "community_pool_history"
+	QueryContinuousFunds             = "continuous_funds"
+	QueryContinuousFund              = "continuous_fund"
 
 	ParamCommunityTax        = "community_tax"
 	ParamBaseProposerReward  = "base_proposer_reward"
@@ -74,7 +79,8 @@ func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress, validatorAddr
 	}
 }
 
-// params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
+// params for query 'custom/distr/delegator_total_rewards', 'custom/distr/delegator_validators'
+// and 'custom/distr/delegator_auto_restakes'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 }
@@ -95,3 +101,51 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// params for query 'custom/distr/validator_reward_history'
+type QueryValidatorRewardHistoryParams struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	StartHeight      int64          `json:"start_height" yaml:"start_height"`
+	EndHeight        int64          `json:"end_height" yaml:"end_height"`
+	Limit            int            `json:"limit" yaml:"limit"`
+}
+
+// creates a new instance of QueryValidatorRewardHistoryParams
+func NewQueryValidatorRewardHistoryParams(validatorAddr sdk.ValAddress, startHeight, endHeight int64,
+	limit int) QueryValidatorRewardHistoryParams {
+
+	return QueryValidatorRewardHistoryParams{
+		ValidatorAddress: validatorAddr,
+		StartHeight:      startHeight,
+		EndHeight:        endHeight,
+		Limit:            limit,
+	}
+}
+
+// params for query 'custom/distr/community_pool_history'
+type QueryCommunityPoolHistoryParams struct {
+	StartHeight int64 `json:"start_height" yaml:"start_height"`
+	EndHeight   int64 `json:"end_height" yaml:"end_height"`
+	Limit       int   `json:"limit" yaml:"limit"`
+}
+
+// creates a new instance of QueryCommunityPoolHistoryParams
+func NewQueryCommunityPoolHistoryParams(startHeight, endHeight int64, limit int) QueryCommunityPoolHistoryParams {
+	return QueryCommunityPoolHistoryParams{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Limit:       limit,
+	}
+}
+
+// params for query 'custom/distr/continuous_fund'
+type QueryContinuousFundParams struct {
+	FundID uint64 `json:"fund_id" yaml:"fund_id"`
+}
+
+// creates a new instance of QueryContinuousFundParams
+func NewQueryContinuousFundParams(fundID uint64) QueryContinuousFundParams {
+	return QueryContinuousFundParams{
+		FundID: fundID,
+	}
+}
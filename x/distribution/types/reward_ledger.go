@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// bounds on the number of records returned by a reward history query
+const (
+	DefaultRewardHistoryLimit = 100
+	MaxRewardHistoryLimit     = 1000
+)
+
+// ValidatorRewardRecord is the commission and outstanding rewards of a
+// validator at a given height, as recorded in the reward ledger
+type ValidatorRewardRecord struct {
+	Height             int64          `json:"height" yaml:"height"`
+	ValidatorAddress   sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Commission         sdk.DecCoins   `json:"commission" yaml:"commission"`
+	OutstandingRewards sdk.DecCoins   `json:"outstanding_rewards" yaml:"outstanding_rewards"`
+}
+
+// NewValidatorRewardRecord creates a new ValidatorRewardRecord instance
+func NewValidatorRewardRecord(height int64, valAddr sdk.ValAddress,
+	commission, outstanding sdk.DecCoins) ValidatorRewardRecord {
+
+	return ValidatorRewardRecord{
+		Height:             height,
+		ValidatorAddress:   valAddr,
+		Commission:         commission,
+		OutstandingRewards: outstanding,
+	}
+}
+
+// String implements the stringer interface.
+func (r ValidatorRewardRecord) String() string {
+	return fmt.Sprintf(`Height %d:
+  Validator:           %s
+  Commission:          %s
+  Outstanding Rewards: %s`, r.Height, r.ValidatorAddress, r.Commission, r.OutstandingRewards)
+}
+
+// ValidatorRewardRecords is a collection of ValidatorRewardRecord
+type ValidatorRewardRecords []ValidatorRewardRecord
+
+// String implements the stringer interface.
+func (rs ValidatorRewardRecords) String() string {
+	out := "Validator Reward History:\n"
+	for _, r := range rs {
+		out += r.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// CommunityPoolRecord is the community pool at a given height and the block
+// rewards allocated to it since the previous record, as recorded in the reward
+// ledger. The other deposits to and the spends from the community pool are not
+// recorded, they only show in the community pool itself.
+type CommunityPoolRecord struct {
+	Height        int64        `json:"height" yaml:"height"`
+	Allocated     sdk.DecCoins `json:"allocated" yaml:"allocated"`
+	CommunityPool sdk.DecCoins `json:"community_pool" yaml:"community_pool"`
+}
+
+// NewCommunityPoolRecord creates a new CommunityPoolRecord instance
+func NewCommunityPoolRecord(height int64, allocated, pool sdk.DecCoins) CommunityPoolRecord {
+	return CommunityPoolRecord{
+		Height:        height,
+		Allocated:     allocated,
+		CommunityPool: pool,
+	}
+}
+
+// String implements the stringer interface.
+func (r CommunityPoolRecord) String() string {
+	return fmt.Sprintf(`Height %d:
+  Allocated:      %s
+  Community Pool: %s`, r.Height, r.Allocated, r.CommunityPool)
+}
+
+// CommunityPoolRecords is a collection of CommunityPoolRecord
+type CommunityPoolRecords []CommunityPoolRecord
+
+// String implements the stringer interface.
+func (rs CommunityPoolRecords) String() string {
+	out := "Community Pool History:\n"
+	for _, r := range rs {
+		out += r.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
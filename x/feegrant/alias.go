@@ -0,0 +1,67 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/barkisnet/barkis/x/feegrant/internal/keeper
+// ALIASGEN: github.com/barkisnet/barkis/x/feegrant/internal/types
+package feegrant
+
+import (
+	"github.com/barkisnet/barkis/x/feegrant/internal/keeper"
+	"github.com/barkisnet/barkis/x/feegrant/internal/types"
+)
+
+const (
+	DefaultCodespace     = types.DefaultCodespace
+	CodeFeeLimitExceeded = types.CodeFeeLimitExceeded
+	CodeFeeLimitExpired  = types.CodeFeeLimitExpired
+	CodeInvalidAllowance = types.CodeInvalidAllowance
+	CodeNoAllowance      = types.CodeNoAllowance
+	CodeSelfGrant        = types.CodeSelfGrant
+	ModuleName           = types.ModuleName
+	StoreKey             = types.StoreKey
+	RouterKey            = types.RouterKey
+	QuerierRoute         = types.QuerierRoute
+	QueryFeeAllowance    = types.QueryFeeAllowance
+	QueryFeeAllowances   = types.QueryFeeAllowances
+)
+
+var (
+	// functions aliases
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	RegisterCodec               = types.RegisterCodec
+	ErrFeeLimitExceeded         = types.ErrFeeLimitExceeded
+	ErrFeeLimitExpired          = types.ErrFeeLimitExpired
+	ErrInvalidAllowance         = types.ErrInvalidAllowance
+	ErrNoAllowance              = types.ErrNoAllowance
+	ErrSelfGrant                = types.ErrSelfGrant
+	NewBasicFeeAllowance        = types.NewBasicFeeAllowance
+	NewPeriodicFeeAllowance     = types.NewPeriodicFeeAllowance
+	NewFeeAllowanceGrant        = types.NewFeeAllowanceGrant
+	NewMsgGrantFeeAllowance     = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance    = types.NewMsgRevokeFeeAllowance
+	NewQueryFeeAllowanceParams  = types.NewQueryFeeAllowanceParams
+	NewQueryFeeAllowancesParams = types.NewQueryFeeAllowancesParams
+	NewGenesisState             = types.NewGenesisState
+	DefaultGenesisState         = types.DefaultGenesisState
+	ValidateGenesis             = types.ValidateGenesis
+	GetFeeAllowanceKey          = types.GetFeeAllowanceKey
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	FeeAllowanceKeyPrefix = types.FeeAllowanceKeyPrefix
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	FeeAllowance             = types.FeeAllowance
+	BasicFeeAllowance        = types.BasicFeeAllowance
+	PeriodicFeeAllowance     = types.PeriodicFeeAllowance
+	FeeAllowanceGrant        = types.FeeAllowanceGrant
+	FeeAllowanceGrants       = types.FeeAllowanceGrants
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	QueryFeeAllowanceParams  = types.QueryFeeAllowanceParams
+	QueryFeeAllowancesParams = types.QueryFeeAllowancesParams
+	GenesisState             = types.GenesisState
+)
@@ -0,0 +1,151 @@
+package feegrant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/barkisnet/barkis/codec"
+	"github.com/barkisnet/barkis/store"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/auth"
+	authtypes "github.com/barkisnet/barkis/x/auth/types"
+	"github.com/barkisnet/barkis/x/params/subspace"
+	supplyexported "github.com/barkisnet/barkis/x/supply/exported"
+)
+
+// burningSupplyKeeper burns the fees sent to the fee collector
+type burningSupplyKeeper struct {
+	ak auth.AccountKeeper
+}
+
+func (sk burningSupplyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, fromAddr sdk.AccAddress, _ string, amt sdk.Coins) sdk.Error {
+	acc := sk.ak.GetAccount(ctx, fromAddr)
+	coins, hasNeg := acc.GetCoins().SafeSub(amt)
+	if hasNeg {
+		return sdk.ErrInsufficientCoins(acc.GetCoins().String())
+	}
+	if err := acc.SetCoins(coins); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	sk.ak.SetAccount(ctx, acc)
+	return nil
+}
+
+func (sk burningSupplyKeeper) GetModuleAccount(sdk.Context, string) supplyexported.ModuleAccountI {
+	return nil
+}
+
+func (sk burningSupplyKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(moduleName)))
+}
+
+func setupAnteTestInput() (sdk.Context, auth.AccountKeeper, Keeper, sdk.AnteHandler) {
+	db := dbm.NewMemDB()
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "barkis/TestMsg", nil)
+
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	feeGrantKey := sdk.NewKVStoreKey(StoreKey)
+	keyParams := sdk.NewKVStoreKey("subspace")
+	tkeyParams := sdk.NewTransientStoreKey("transient_subspace")
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(feeGrantKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	_ = ms.LoadLatestVersion()
+
+	ps := subspace.NewSubspace(cdc, keyParams, tkeyParams, auth.DefaultParamspace)
+	ak := auth.NewAccountKeeper(cdc, authKey, ps, auth.ProtoBaseAccount)
+	fk := NewKeeper(cdc, feeGrantKey, DefaultCodespace)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id", Height: 1, Time: time.Now()}, false, log.NewNopLogger())
+	ak.SetParams(ctx, auth.DefaultParams())
+
+	anteHandler := auth.NewAnteHandlerWithFeeGrant(ak, burningSupplyKeeper{ak}, fk, nil, auth.DefaultSigVerificationGasConsumer)
+	return ctx, ak, fk, anteHandler
+}
+
+func TestAnteHandlerFeeGrant(t *testing.T) {
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.FeeGrantUpgrade, 1)
+	sdk.GlobalUpgradeMgr.SetBlockHeight(1)
+	defer func() {
+		delete(sdk.GlobalUpgradeMgr.Config.UpgradeHeight, sdk.FeeGrantUpgrade)
+		sdk.GlobalUpgradeMgr.SetBlockHeight(0)
+	}()
+
+	ctx, ak, fk, anteHandler := setupAnteTestInput()
+	now := ctx.BlockHeader().Time
+
+	_, _, granter := authtypes.KeyTestPubAddr()
+	priv, _, grantee := authtypes.KeyTestPubAddr()
+
+	fee := authtypes.NewTestStdFee().WithFeePayer(granter)
+	granterAcc := ak.NewAccountWithAddress(ctx, granter)
+	require.NoError(t, granterAcc.SetCoins(fee.Amount.Add(fee.Amount)))
+	ak.SetAccount(ctx, granterAcc)
+	granteeAcc := ak.NewAccountWithAddress(ctx, grantee)
+	ak.SetAccount(ctx, granteeAcc)
+
+	newTx := func(seq uint64) sdk.Tx {
+		msgs := []sdk.Msg{sdk.NewTestMsg(grantee)}
+		return authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{granteeAcc.GetAccountNumber()}, []uint64{seq}, fee)
+	}
+	runTx := func(tx sdk.Tx) sdk.Result {
+		// like the base app, only keep the changes of a successful ante handler
+		cacheCtx, write := ctx.CacheContext()
+		_, res, abort := anteHandler(cacheCtx, tx, false)
+		if !abort {
+			write()
+		}
+		return res
+	}
+
+	// revoked or never granted allowance
+	res := runTx(newTx(0))
+	require.Equal(t, CodeNoAllowance, res.Code, res.Log)
+	require.Equal(t, fee.Amount.Add(fee.Amount), ak.GetAccount(ctx, granter).GetCoins())
+
+	// the fees are paid by the granter and charged against the allowance
+	spendLimit := fee.Amount.Add(fee.Amount).Add(fee.Amount)
+	fk.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(granter, grantee, NewBasicFeeAllowance(spendLimit, time.Time{})))
+	res = runTx(newTx(0))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, fee.Amount, ak.GetAccount(ctx, granter).GetCoins())
+	require.True(t, ak.GetAccount(ctx, grantee).GetCoins().Empty())
+	require.Equal(t, fee.Amount.Add(fee.Amount), fk.GetFeeAllowance(ctx, granter, grantee).(*BasicFeeAllowance).SpendLimit)
+
+	// the allowance is left untouched when the granter cannot pay the fees
+	granterAcc = ak.GetAccount(ctx, granter)
+	require.NoError(t, granterAcc.SetCoins(sdk.NewCoins()))
+	ak.SetAccount(ctx, granterAcc)
+	res = runTx(newTx(1))
+	require.Equal(t, sdk.CodeInsufficientFunds, res.Code, res.Log)
+	require.Equal(t, fee.Amount.Add(fee.Amount), fk.GetFeeAllowance(ctx, granter, grantee).(*BasicFeeAllowance).SpendLimit)
+
+	// exceeded allowance
+	require.NoError(t, granterAcc.SetCoins(fee.Amount.Add(fee.Amount)))
+	ak.SetAccount(ctx, granterAcc)
+	fk.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(granter, grantee, NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), time.Time{})))
+	res = runTx(newTx(1))
+	require.Equal(t, CodeFeeLimitExceeded, res.Code, res.Log)
+	require.Equal(t, fee.Amount.Add(fee.Amount), ak.GetAccount(ctx, granter).GetCoins())
+	require.NotNil(t, fk.GetFeeAllowance(ctx, granter, grantee))
+
+	// expired allowance
+	fk.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(granter, grantee, NewBasicFeeAllowance(spendLimit, now)))
+	res = runTx(newTx(1))
+	require.Equal(t, CodeFeeLimitExpired, res.Code, res.Log)
+	require.Equal(t, fee.Amount.Add(fee.Amount), ak.GetAccount(ctx, granter).GetCoins())
+}
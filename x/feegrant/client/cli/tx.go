@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/barkisnet/barkis/client"
+	"github.com/barkisnet/barkis/client/context"
+	"github.com/barkisnet/barkis/codec"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/auth"
+	"github.com/barkisnet/barkis/x/auth/client/utils"
+	"github.com/barkisnet/barkis/x/feegrant/internal/types"
+)
+
+const (
+	flagSpendLimit  = "spend-limit"
+	flagExpiration  = "expiration"
+	flagPeriod      = "period"
+	flagPeriodLimit = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Feegrant transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(client.PostCommands(
+		GrantFeeAllowanceTxCmd(cdc),
+		RevokeFeeAllowanceTxCmd(cdc),
+	)...)
+	return txCmd
+}
+
+// GrantFeeAllowanceTxCmd will create a tx to grant a fee allowance to a
+// grantee and sign it with the given key.
+func GrantFeeAllowanceTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant a fee allowance to an account",
+		Long: `Grant an allowance to an account to pay the fees of its transactions out
+of your account. The total amount may be limited with --spend-limit and the
+allowance may expire at the --expiration time (RFC3339). Setting both --period
+and --period-limit additionally limits the amount spent per period.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := buildFeeAllowance()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "Maximum amount of fees which can be paid in total; unlimited if empty")
+	cmd.Flags().String(flagExpiration, "", "Time at which the allowance expires (RFC3339); never if empty")
+	cmd.Flags().String(flagPeriod, "", "Duration of a period, e.g. 24h")
+	cmd.Flags().String(flagPeriodLimit, "", "Maximum amount of fees which can be paid per period")
+
+	return cmd
+}
+
+// buildFeeAllowance builds a basic or periodic fee allowance from the flags.
+func buildFeeAllowance() (types.FeeAllowance, error) {
+	var basic types.BasicFeeAllowance
+
+	if spendLimit := viper.GetString(flagSpendLimit); spendLimit != "" {
+		coins, err := sdk.ParseCoins(spendLimit)
+		if err != nil {
+			return nil, err
+		}
+		basic.SpendLimit = coins
+	}
+
+	if expiration := viper.GetString(flagExpiration); expiration != "" {
+		t, err := time.Parse(time.RFC3339, expiration)
+		if err != nil {
+			return nil, err
+		}
+		basic.Expiration = t
+	}
+
+	period, periodLimit := viper.GetString(flagPeriod), viper.GetString(flagPeriodLimit)
+	if period == "" && periodLimit == "" {
+		return &basic, nil
+	}
+
+	duration, err := time.ParseDuration(period)
+	if err != nil {
+		return nil, err
+	}
+
+	coins, err := sdk.ParseCoins(periodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewPeriodicFeeAllowance(basic, duration, coins), nil
+}
+
+// RevokeFeeAllowanceTxCmd will create a tx to revoke the fee allowance granted
+// to a grantee and sign it with the given key.
+func RevokeFeeAllowanceTxCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke the fee allowance granted to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
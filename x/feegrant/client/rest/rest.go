@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/barkisnet/barkis/client/context"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/feegrant/allowances/{grantee}", GrantFeeAllowanceRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/feegrant/allowances/{grantee}/revoke", RevokeFeeAllowanceRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/feegrant/allowances/{grantee}", QueryFeeAllowancesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/feegrant/allowances/{grantee}/{granter}", QueryFeeAllowanceRequestHandlerFn(cliCtx)).Methods("GET")
+}
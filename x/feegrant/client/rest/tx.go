@@ -0,0 +1,126 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/barkisnet/barkis/client/context"
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/types/rest"
+	"github.com/barkisnet/barkis/x/auth/client/utils"
+	"github.com/barkisnet/barkis/x/feegrant/internal/types"
+)
+
+// GrantFeeAllowanceReq defines the properties of a grant fee allowance
+// request's body. The allowance is periodic when a period is set.
+type GrantFeeAllowanceReq struct {
+	BaseReq     rest.BaseReq  `json:"base_req" yaml:"base_req"`
+	SpendLimit  sdk.Coins     `json:"spend_limit" yaml:"spend_limit"`
+	Expiration  time.Time     `json:"expiration" yaml:"expiration"`
+	Period      time.Duration `json:"period" yaml:"period"`
+	PeriodLimit sdk.Coins     `json:"period_limit" yaml:"period_limit"`
+}
+
+// GrantFeeAllowanceRequestHandlerFn - http request handler to grant a fee
+// allowance to a grantee.
+func GrantFeeAllowanceRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		grantee, err := sdk.AccAddressFromBech32(vars["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req GrantFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		basic := types.NewBasicFeeAllowance(req.SpendLimit, req.Expiration)
+
+		var allowance types.FeeAllowance = basic
+		if req.Period != 0 {
+			allowance = types.NewPeriodicFeeAllowance(*basic, req.Period, req.PeriodLimit)
+		}
+
+		msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// RevokeFeeAllowanceReq defines the properties of a revoke fee allowance
+// request's body.
+type RevokeFeeAllowanceReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+// RevokeFeeAllowanceRequestHandlerFn - http request handler to revoke the fee
+// allowance granted to a grantee.
+func RevokeFeeAllowanceRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		grantee, err := sdk.AccAddressFromBech32(vars["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req RevokeFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		cliCtx, ok := withFromFields(w, cliCtx, req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// withFromFields derives the from account address and name of a request,
+// from the Keybase unless only generating the transaction, and sets them on
+// the context.
+func withFromFields(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq) (context.CLIContext, bool) {
+	var fromAddress sdk.AccAddress
+	var fromName string
+	var err error
+	if baseReq.GenerateOnly {
+		fromAddress, err = sdk.AccAddressFromBech32(baseReq.From)
+	} else {
+		fromAddress, fromName, err = context.GetFromFieldsFromAddr(baseReq.From)
+	}
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return cliCtx, false
+	}
+
+	return cliCtx.WithFromName(fromName).WithFromAddress(fromAddress).WithBroadcastMode(baseReq.BroadcastMode), true
+}
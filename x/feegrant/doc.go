@@ -0,0 +1,10 @@
+/*
+Package feegrant provides functionality for granting fee allowances.
+
+A granter can allow a grantee to pay the fees of its transactions out of the
+granter's account, up to a spend limit which may be reset every period and
+which may expire. The grantee sets the granter as fee payer in the StdFee of
+its transactions; the auth ante handler then charges the fees against the
+allowance and deducts them from the granter's account.
+*/
+package feegrant
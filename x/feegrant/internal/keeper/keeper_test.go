@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/feegrant/internal/types"
+)
+
+var (
+	addr1 = sdk.AccAddress(crypto.AddressHash([]byte("addr1")))
+	addr2 = sdk.AccAddress(crypto.AddressHash([]byte("addr2")))
+	addr3 = sdk.AccAddress(crypto.AddressHash([]byte("addr3")))
+)
+
+func TestGrantRevokeFeeAllowance(t *testing.T) {
+	_, ctx, keeper := SetupTestInput()
+
+	allowance := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), time.Time{})
+	require.Nil(t, keeper.GetFeeAllowance(ctx, addr1, addr2))
+
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr1, addr2, allowance))
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr3, addr2, allowance))
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr1, addr3, allowance))
+	require.Equal(t, allowance, keeper.GetFeeAllowance(ctx, addr1, addr2))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, addr2, addr1))
+
+	var grants types.FeeAllowanceGrants
+	keeper.IterateAllGranteeFeeAllowances(ctx, addr2, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 2)
+
+	count := 0
+	keeper.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 3, count)
+
+	require.NoError(t, keeper.RevokeFeeAllowance(ctx, addr1, addr2))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, addr1, addr2))
+	require.Error(t, keeper.RevokeFeeAllowance(ctx, addr1, addr2))
+}
+
+func TestUseGrantedFees(t *testing.T) {
+	_, ctx, keeper := SetupTestInput()
+	now := time.Now()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 60))
+
+	// no allowance
+	require.Error(t, keeper.UseGrantedFees(ctx, addr1, addr2, fee))
+
+	// the allowance is updated and removed once exhausted
+	allowance := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 120)), time.Time{})
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr1, addr2, allowance))
+
+	require.NoError(t, keeper.UseGrantedFees(ctx, addr1, addr2, fee))
+	remaining := keeper.GetFeeAllowance(ctx, addr1, addr2).(*types.BasicFeeAllowance)
+	require.True(t, fee.IsEqual(remaining.SpendLimit))
+
+	require.Error(t, keeper.UseGrantedFees(ctx, addr1, addr2, fee.Add(fee)))
+	require.NoError(t, keeper.UseGrantedFees(ctx, addr1, addr2, fee))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, addr1, addr2))
+
+	// an expired allowance is rejected and removed
+	expired := types.NewBasicFeeAllowance(nil, now)
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr1, addr3, expired))
+	require.Error(t, keeper.UseGrantedFees(ctx, addr1, addr3, fee))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, addr1, addr3))
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// Fee grant errors reserve 100 ~ 199.
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeFeeLimitExceeded sdk.CodeType = 101
+	CodeFeeLimitExpired  sdk.CodeType = 102
+	CodeInvalidAllowance sdk.CodeType = 103
+	CodeNoAllowance      sdk.CodeType = 104
+	CodeSelfGrant        sdk.CodeType = 105
+)
+
+// ErrFeeLimitExceeded is an error
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExceeded, fmt.Sprintf("fee limit exceeded: %s", msg))
+}
+
+// ErrFeeLimitExpired is an error
+func ErrFeeLimitExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExpired, "fee allowance expired")
+}
+
+// ErrInvalidAllowance is an error
+func ErrInvalidAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAllowance, fmt.Sprintf("invalid fee allowance: %s", msg))
+}
+
+// ErrNoAllowance is an error
+func ErrNoAllowance(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAllowance, fmt.Sprintf("no fee allowance from %s to %s", granter, grantee))
+}
+
+// ErrSelfGrant is an error
+func ErrSelfGrant(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSelfGrant, "granter and grantee cannot be the same account")
+}
@@ -0,0 +1,13 @@
+package types
+
+// fee grant module event types
+const (
+	EventTypeGrantFeeAllowance  = "grant_fee_allowance"
+	EventTypeRevokeFeeAllowance = "revoke_fee_allowance"
+	EventTypeUseFeeAllowance    = "use_fee_allowance"
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+
+	AttributeValueCategory = ModuleName
+)
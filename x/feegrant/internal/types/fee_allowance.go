@@ -0,0 +1,197 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// FeeAllowance defines the permissions for one account to use the fees of
+// another account.
+type FeeAllowance interface {
+	// Accept can use the fee payment requested as well as the time of the
+	// current block to determine whether or not to process this. On success it
+	// updates the allowance in place, so the caller must persist it again.
+	//
+	// If remove is true the allowance is exhausted or expired and should be
+	// deleted from the store.
+	Accept(fee sdk.Coins, blockTime time.Time) (remove bool, err sdk.Error)
+
+	// ValidateBasic performs stateless validation of the allowance.
+	ValidateBasic() sdk.Error
+}
+
+var (
+	_ FeeAllowance = (*BasicFeeAllowance)(nil)
+	_ FeeAllowance = (*PeriodicFeeAllowance)(nil)
+)
+
+// BasicFeeAllowance implements FeeAllowance with a one-time grant of coins
+// that optionally expires. The delegatee can use up to SpendLimit to cover
+// fees; a nil SpendLimit means no limit. A zero Expiration never expires.
+type BasicFeeAllowance struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+	Expiration time.Time `json:"expiration" yaml:"expiration"`
+}
+
+// NewBasicFeeAllowance creates a new BasicFeeAllowance object
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration time.Time) *BasicFeeAllowance {
+	return &BasicFeeAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Accept implements FeeAllowance. The fee is deducted from SpendLimit.
+func (a *BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (bool, sdk.Error) {
+	if a.isExpired(blockTime) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	if a.SpendLimit == nil {
+		return false, nil
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(fee)
+	if invalid {
+		return false, ErrFeeLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s", fee, a.SpendLimit))
+	}
+
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+func (a BasicFeeAllowance) isExpired(blockTime time.Time) bool {
+	return !a.Expiration.IsZero() && !blockTime.Before(a.Expiration)
+}
+
+// ValidateBasic implements FeeAllowance.
+func (a BasicFeeAllowance) ValidateBasic() sdk.Error {
+	if a.SpendLimit != nil {
+		if !a.SpendLimit.IsValid() {
+			return sdk.ErrInvalidCoins("spend limit is invalid: " + a.SpendLimit.String())
+		}
+		if !a.SpendLimit.IsAllPositive() {
+			return sdk.ErrInvalidCoins("spend limit must be positive")
+		}
+	}
+	return nil
+}
+
+// String implements the stringer interface.
+func (a BasicFeeAllowance) String() string {
+	var sb strings.Builder
+	sb.WriteString("Basic Fee Allowance:\n")
+	sb.WriteString(fmt.Sprintf("  Spend Limit: %s\n", a.SpendLimit))
+	sb.WriteString(fmt.Sprintf("  Expiration:  %s\n", a.Expiration))
+	return sb.String()
+}
+
+// PeriodicFeeAllowance extends a BasicFeeAllowance with a limit of coins
+// which can be spent per period. PeriodCanSpend is what is left to spend in
+// the current period, which ends at PeriodReset.
+type PeriodicFeeAllowance struct {
+	Basic            BasicFeeAllowance `json:"basic" yaml:"basic"`
+	Period           time.Duration     `json:"period" yaml:"period"`
+	PeriodSpendLimit sdk.Coins         `json:"period_spend_limit" yaml:"period_spend_limit"`
+	PeriodCanSpend   sdk.Coins         `json:"period_can_spend" yaml:"period_can_spend"`
+	PeriodReset      time.Time         `json:"period_reset" yaml:"period_reset"`
+}
+
+// NewPeriodicFeeAllowance creates a new PeriodicFeeAllowance object. The first
+// period starts with the first fee paid out of the allowance.
+func NewPeriodicFeeAllowance(basic BasicFeeAllowance, period time.Duration,
+	periodSpendLimit sdk.Coins) *PeriodicFeeAllowance {
+
+	return &PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Accept implements FeeAllowance. The fee is deducted from both the total
+// spend limit and the coins which can still be spent in the current period.
+func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (bool, sdk.Error) {
+	if a.Basic.isExpired(blockTime) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	a.tryResetPeriod(blockTime)
+
+	canSpend, invalid := a.PeriodCanSpend.SafeSub(fee)
+	if invalid {
+		return false, ErrFeeLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s left in period", fee, a.PeriodCanSpend))
+	}
+
+	a.PeriodCanSpend = canSpend
+	if a.Basic.SpendLimit == nil {
+		return false, nil
+	}
+
+	left, invalid := a.Basic.SpendLimit.SafeSub(fee)
+	if invalid {
+		return false, ErrFeeLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s", fee, a.Basic.SpendLimit))
+	}
+
+	a.Basic.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// tryResetPeriod starts a new period once the current one has passed. The
+// coins which can be spent are reset to the period limit, capped by what is
+// left of the total spend limit.
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	if a.Basic.SpendLimit != nil {
+		if _, invalid := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit); invalid {
+			a.PeriodCanSpend = a.Basic.SpendLimit
+		}
+	}
+
+	// the next period starts right after the current one, unless the
+	// allowance went unused for longer than a period
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements FeeAllowance.
+func (a PeriodicFeeAllowance) ValidateBasic() sdk.Error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+	if a.Period <= 0 {
+		return ErrInvalidAllowance(DefaultCodespace, "period must be positive")
+	}
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdk.ErrInvalidCoins("period spend limit is invalid: " + a.PeriodSpendLimit.String())
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return sdk.ErrInvalidCoins("period spend limit must be positive")
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdk.ErrInvalidCoins("period can spend is invalid: " + a.PeriodCanSpend.String())
+	}
+	return nil
+}
+
+// String implements the stringer interface.
+func (a PeriodicFeeAllowance) String() string {
+	var sb strings.Builder
+	sb.WriteString("Periodic Fee Allowance:\n")
+	sb.WriteString(fmt.Sprintf("  Spend Limit:        %s\n", a.Basic.SpendLimit))
+	sb.WriteString(fmt.Sprintf("  Expiration:         %s\n", a.Basic.Expiration))
+	sb.WriteString(fmt.Sprintf("  Period:             %s\n", a.Period))
+	sb.WriteString(fmt.Sprintf("  Period Spend Limit: %s\n", a.PeriodSpendLimit))
+	sb.WriteString(fmt.Sprintf("  Period Can Spend:   %s\n", a.PeriodCanSpend))
+	sb.WriteString(fmt.Sprintf("  Period Reset:       %s\n", a.PeriodReset))
+	return sb.String()
+}
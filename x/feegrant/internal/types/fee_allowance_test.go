@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+func TestBasicFeeAllowanceAccept(t *testing.T) {
+	now := time.Now()
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+
+	cases := map[string]struct {
+		allowance *BasicFeeAllowance
+		blockTime time.Time
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"unlimited":    {NewBasicFeeAllowance(nil, time.Time{}), now, true, false, nil},
+		"within limit": {NewBasicFeeAllowance(atom, time.Time{}), now, true, false, sdk.NewCoins(sdk.NewInt64Coin("atom", 60))},
+		"exhausted":    {NewBasicFeeAllowance(fee, time.Time{}), now, true, true, sdk.Coins{}},
+		"over limit":   {NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 20)), time.Time{}), now, false, false, nil},
+		"wrong denom":  {NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("eth", 100)), time.Time{}), now, false, false, nil},
+		"not expired":  {NewBasicFeeAllowance(atom, now.Add(time.Hour)), now, true, false, sdk.NewCoins(sdk.NewInt64Coin("atom", 60))},
+		"expired":      {NewBasicFeeAllowance(atom, now), now, false, true, nil},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, tc.allowance.ValidateBasic())
+
+			remove, err := tc.allowance.Accept(fee, tc.blockTime)
+			require.Equal(t, tc.accept, err == nil)
+			require.Equal(t, tc.remove, remove)
+			if tc.accept && tc.remains != nil {
+				require.True(t, tc.remains.IsEqual(tc.allowance.SpendLimit))
+			}
+		})
+	}
+}
+
+func TestPeriodicFeeAllowanceAccept(t *testing.T) {
+	now := time.Now()
+	period := time.Hour
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+
+	allowance := NewPeriodicFeeAllowance(
+		*NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), time.Time{}),
+		period, sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+	)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// the first use starts the period
+	remove, err := allowance.Accept(fee, now)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, now.Add(period), allowance.PeriodReset)
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)).IsEqual(allowance.PeriodCanSpend))
+
+	// the period limit is reached
+	_, err = allowance.Accept(fee, now.Add(time.Minute))
+	require.Error(t, err)
+
+	// the next period can spend again
+	remove, err = allowance.Accept(fee, now.Add(period))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, now.Add(2*period), allowance.PeriodReset)
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 20)).IsEqual(allowance.Basic.SpendLimit))
+
+	// after skipped periods, the spend is capped by what is left of the total
+	_, err = allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("atom", 30)), now.Add(5*period))
+	require.Error(t, err)
+	require.Equal(t, now.Add(6*period), allowance.PeriodReset)
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 20)).IsEqual(allowance.PeriodCanSpend))
+
+	remove, err = allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("atom", 20)), now.Add(5*period))
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestPeriodicFeeAllowanceValidateBasic(t *testing.T) {
+	basic := *NewBasicFeeAllowance(nil, time.Time{})
+	limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+
+	require.NoError(t, NewPeriodicFeeAllowance(basic, time.Hour, limit).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(basic, 0, limit).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(basic, time.Hour, nil).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(basic, time.Hour, sdk.Coins{sdk.NewInt64Coin("atom", 0)}).ValidateBasic())
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState contains a set of fee allowances, persisted from the store
+type GenesisState struct {
+	FeeAllowances []FeeAllowanceGrant `json:"fee_allowances" yaml:"fee_allowances"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(feeAllowances []FeeAllowanceGrant) GenesisState {
+	return GenesisState{FeeAllowances: feeAllowances}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]FeeAllowanceGrant{})
+}
+
+// ValidateGenesis performs basic validation of fee grant genesis data
+// returning an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool)
+	for _, grant := range data.FeeAllowances {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(GetFeeAllowanceKey(grant.Granter, grant.Grantee))
+		if seen[key] {
+			return fmt.Errorf("duplicate fee allowance from %s to %s", grant.Granter, grant.Grantee)
+		}
+		seen[key] = true
+	}
+	return nil
+}
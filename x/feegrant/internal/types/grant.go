@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// FeeAllowanceGrant is stored in the KVStore to record a grant with full context
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Allowance FeeAllowance   `json:"allowance" yaml:"allowance"`
+}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant object
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// ValidateBasic performs basic validation of the grant
+func (g FeeAllowanceGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return ErrSelfGrant(DefaultCodespace)
+	}
+	if g.Allowance == nil {
+		return ErrInvalidAllowance(DefaultCodespace, "missing allowance")
+	}
+	return g.Allowance.ValidateBasic()
+}
+
+// String implements the stringer interface.
+func (g FeeAllowanceGrant) String() string {
+	return fmt.Sprintf(`Granter: %s
+Grantee: %s
+%s`, g.Granter, g.Grantee, g.Allowance)
+}
+
+// FeeAllowanceGrants is a collection of FeeAllowanceGrant
+type FeeAllowanceGrants []FeeAllowanceGrant
+
+// String implements the stringer interface.
+func (gs FeeAllowanceGrants) String() string {
+	if len(gs) == 0 {
+		return "[]"
+	}
+
+	out := ""
+	for _, g := range gs {
+		out += g.String() + "\n"
+	}
+	return out[:len(out)-1]
+}
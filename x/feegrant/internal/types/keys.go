@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for fee grants
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for fee grants
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for fee grants
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
+	FeeAllowanceKeyPrefix = []byte{0x01}
+)
+
+// GetFeeAllowanceKey is the key used to store a fee allowance, which is
+// indexed by grantee first so that all allowances of a grantee can be iterated.
+func GetFeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetFeeAllowancesByGranteeKey(grantee), granter.Bytes()...)
+}
+
+// GetFeeAllowancesByGranteeKey is the prefix of all fee allowances granted to a grantee
+func GetFeeAllowancesByGranteeKey(grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, grantee.Bytes()...)
+}
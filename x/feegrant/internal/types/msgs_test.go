@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+func TestMsgGrantFeeAllowanceValidation(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+	allowance := NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), time.Time{})
+
+	cases := []struct {
+		valid bool
+		msg   MsgGrantFeeAllowance
+	}{
+		{true, NewMsgGrantFeeAllowance(granter, grantee, allowance)},
+		{false, NewMsgGrantFeeAllowance(nil, grantee, allowance)},
+		{false, NewMsgGrantFeeAllowance(granter, nil, allowance)},
+		{false, NewMsgGrantFeeAllowance(granter, granter, allowance)},
+		{false, NewMsgGrantFeeAllowance(granter, grantee, nil)},
+		{false, NewMsgGrantFeeAllowance(granter, grantee, NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("atom", 0)}, time.Time{}))},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		require.Equal(t, tc.valid, err == nil, "case %d", i)
+	}
+}
+
+func TestMsgGrantFeeAllowanceGetSignBytes(t *testing.T) {
+	msg := NewMsgGrantFeeAllowance(sdk.AccAddress([]byte("granter")), sdk.AccAddress([]byte("grantee")),
+		NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), time.Time{}))
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+	require.Equal(t, []sdk.AccAddress{msg.Granter}, msg.GetSigners())
+}
+
+func TestMsgRevokeFeeAllowanceValidation(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+
+	require.NoError(t, NewMsgRevokeFeeAllowance(granter, grantee).ValidateBasic())
+	require.Error(t, NewMsgRevokeFeeAllowance(nil, grantee).ValidateBasic())
+	require.Error(t, NewMsgRevokeFeeAllowance(granter, nil).ValidateBasic())
+}
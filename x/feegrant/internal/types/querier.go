@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// query endpoints supported by the fee grant Querier
+const (
+	QueryFeeAllowance  = "fee_allowance"
+	QueryFeeAllowances = "fee_allowances"
+)
+
+// QueryFeeAllowanceParams defines the params for querying the fee allowance
+// from a granter to a grantee.
+type QueryFeeAllowanceParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowanceParams creates a new instance of QueryFeeAllowanceParams.
+func NewQueryFeeAllowanceParams(granter, grantee sdk.AccAddress) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{Granter: granter, Grantee: grantee}
+}
+
+// QueryFeeAllowancesParams defines the params for querying all fee allowances
+// granted to a grantee.
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowancesParams creates a new instance of QueryFeeAllowancesParams.
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{Grantee: grantee}
+}
@@ -26,6 +26,9 @@ type GenesisAccount struct {
 	StartTime        int64     `json:"start_time" yaml:"start_time"`               // vesting start time (UNIX Epoch time)
 	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // vesting end time (UNIX Epoch time)
 
+	// periodic vesting account fields
+	VestingPeriods auth.Periods `json:"vesting_periods" yaml:"vesting_periods"` // unlocking schedule relative to the start time
+
 	// module account fields
 	ModuleName        string   `json:"module_name" yaml:"module_name"`               // name of the module account
 	ModulePermissions []string `json:"module_permissions" yaml:"module_permissions"` // permissions of module account
@@ -42,6 +45,18 @@ func (ga GenesisAccount) Validate() error {
 		}
 	}
 
+	if len(ga.VestingPeriods) > 0 {
+		if err := ga.VestingPeriods.Validate(); err != nil {
+			return err
+		}
+		if !ga.VestingPeriods.TotalAmount().IsEqual(ga.OriginalVesting) {
+			return errors.New("vesting periods total amount must equal the vesting amount")
+		}
+		if endTime, err := ga.VestingPeriods.EndTime(ga.StartTime); err != nil || endTime != ga.EndTime {
+			return errors.New("vesting periods total length must equal the vesting duration")
+		}
+	}
+
 	// don't allow blank (i.e just whitespaces) on the module name
 	if ga.ModuleName != "" && strings.TrimSpace(ga.ModuleName) == "" {
 		return errors.New("module account name cannot be blank")
@@ -94,6 +109,13 @@ func NewGenesisAccountI(acc authexported.Account) (GenesisAccount, error) {
 	}
 
 	switch acc := acc.(type) {
+	case *auth.PeriodicVestingAccount:
+		gacc.OriginalVesting = acc.GetOriginalVesting()
+		gacc.DelegatedFree = acc.GetDelegatedFree()
+		gacc.DelegatedVesting = acc.GetDelegatedVesting()
+		gacc.StartTime = acc.GetStartTime()
+		gacc.EndTime = acc.GetEndTime()
+		gacc.VestingPeriods = acc.GetVestingPeriods()
 	case authexported.VestingAccount:
 		gacc.OriginalVesting = acc.GetOriginalVesting()
 		gacc.DelegatedFree = acc.GetDelegatedFree()
@@ -120,6 +142,8 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 		)
 
 		switch {
+		case len(ga.VestingPeriods) > 0:
+			return auth.NewPeriodicVestingAccountRaw(baseVestingAcc, ga.StartTime, ga.VestingPeriods)
 		case ga.StartTime != 0 && ga.EndTime != 0:
 			return auth.NewContinuousVestingAccountRaw(baseVestingAcc, ga.StartTime)
 		case ga.EndTime != 0:
@@ -87,6 +87,18 @@ func TestToAccount(t *testing.T) {
 	require.IsType(t, &auth.ContinuousVestingAccount{}, acc)
 	require.Equal(t, vacc, acc.(*auth.ContinuousVestingAccount))
 
+	// periodic vesting account
+	periods := auth.Periods{
+		auth.Period{Length: int64(12 * 60 * 60), Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+		auth.Period{Length: int64(12 * 60 * 60), Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))},
+	}
+	pvacc := auth.NewPeriodicVestingAccount(&authAcc, time.Now().Unix(), periods)
+	genAcc, err = NewGenesisAccountI(pvacc)
+	require.NoError(t, err)
+	acc = genAcc.ToAccount()
+	require.IsType(t, &auth.PeriodicVestingAccount{}, acc)
+	require.Equal(t, pvacc, acc.(*auth.PeriodicVestingAccount))
+
 	// module account
 	macc := supply.NewEmptyModuleAccount("mint", supply.Minter)
 	genAcc, err = NewGenesisAccountI(macc)
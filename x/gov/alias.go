@@ -23,6 +23,8 @@ const (
 	CodeInvalidGenesis           = types.CodeInvalidGenesis
 	CodeInvalidProposalStatus    = types.CodeInvalidProposalStatus
 	CodeProposalHandlerNotExists = types.CodeProposalHandlerNotExists
+	CodeInsufficientDeposit      = types.CodeInsufficientDeposit
+	CodeInsufficientFunds        = types.CodeInsufficientFunds
 	ModuleName                   = types.ModuleName
 	StoreKey                     = types.StoreKey
 	RouterKey                    = types.RouterKey
@@ -30,6 +32,7 @@ const (
 	DefaultParamspace            = types.DefaultParamspace
 	TypeMsgDeposit               = types.TypeMsgDeposit
 	TypeMsgVote                  = types.TypeMsgVote
+	TypeMsgVoteWeighted          = types.TypeMsgVoteWeighted
 	TypeMsgSubmitProposal        = types.TypeMsgSubmitProposal
 	StatusNil                    = types.StatusNil
 	StatusDepositPeriod          = types.StatusDepositPeriod
@@ -39,6 +42,7 @@ const (
 	StatusFailed                 = types.StatusFailed
 	ProposalTypeText             = types.ProposalTypeText
 	ProposalTypeSoftwareUpgrade  = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeExecMsgs         = types.ProposalTypeExecMsgs
 	QueryParams                  = types.QueryParams
 	QueryProposals               = types.QueryProposals
 	QueryProposal                = types.QueryProposal
@@ -50,6 +54,9 @@ const (
 	ParamDeposit                 = types.ParamDeposit
 	ParamVoting                  = types.ParamVoting
 	ParamTallying                = types.ParamTallying
+	ParamExpedited               = types.ParamExpedited
+	ParamDepositPolicy           = types.ParamDepositPolicy
+	DefaultExpeditedPeriod       = types.DefaultExpeditedPeriod
 	OptionEmpty                  = types.OptionEmpty
 	OptionYes                    = types.OptionYes
 	OptionAbstain                = types.OptionAbstain
@@ -71,8 +78,11 @@ var (
 	ErrInvalidProposalContent     = types.ErrInvalidProposalContent
 	ErrInvalidProposalType        = types.ErrInvalidProposalType
 	ErrInvalidVote                = types.ErrInvalidVote
+	ErrInvalidWeightedVote        = types.ErrInvalidWeightedVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrInsufficientInitialDeposit = types.ErrInsufficientInitialDeposit
+	ErrInsufficientFunds          = types.ErrInsufficientFunds
 	ProposalKey                   = types.ProposalKey
 	ActiveProposalByTimeKey       = types.ActiveProposalByTimeKey
 	ActiveProposalQueueKey        = types.ActiveProposalQueueKey
@@ -90,9 +100,15 @@ var (
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
+	NewExpeditedParams            = types.NewExpeditedParams
+	DefaultExpeditedParams        = types.DefaultExpeditedParams
+	NewProposalTypeMinDeposit     = types.NewProposalTypeMinDeposit
+	NewDepositPolicyParams        = types.NewDepositPolicyParams
+	DefaultDepositPolicyParams    = types.DefaultDepositPolicyParams
 	NewVotingParams               = types.NewVotingParams
 	NewParams                     = types.NewParams
 	NewProposal                   = types.NewProposal
@@ -103,7 +119,11 @@ var (
 	EmptyTallyResult              = types.EmptyTallyResult
 	NewTextProposal               = types.NewTextProposal
 	NewSoftwareUpgradeProposal    = types.NewSoftwareUpgradeProposal
+	NewExecMsgsProposal           = types.NewExecMsgsProposal
+	NewExecResult                 = types.NewExecResult
 	RegisterProposalType          = types.RegisterProposalType
+	RegisterProposalTypeUpgrade   = types.RegisterProposalTypeUpgrade
+	IsProposalTypeEnabled         = types.IsProposalTypeEnabled
 	ContentFromProposalType       = types.ContentFromProposalType
 	IsValidProposalType           = types.IsValidProposalType
 	ProposalHandler               = types.ProposalHandler
@@ -114,18 +134,26 @@ var (
 	NewVote                       = types.NewVote
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
+	NewWeightedVote               = types.NewWeightedVote
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	ValidWeightedVoteOptions      = types.ValidWeightedVoteOptions
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
 
 	// variable aliases
-	ModuleCdc                   = types.ModuleCdc
-	ProposalsKeyPrefix          = types.ProposalsKeyPrefix
-	ActiveProposalQueuePrefix   = types.ActiveProposalQueuePrefix
-	InactiveProposalQueuePrefix = types.InactiveProposalQueuePrefix
-	ProposalIDKey               = types.ProposalIDKey
-	DepositsKeyPrefix           = types.DepositsKeyPrefix
-	VotesKeyPrefix              = types.VotesKeyPrefix
-	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
-	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
-	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
+	ModuleCdc                    = types.ModuleCdc
+	ProposalsKeyPrefix           = types.ProposalsKeyPrefix
+	ActiveProposalQueuePrefix    = types.ActiveProposalQueuePrefix
+	InactiveProposalQueuePrefix  = types.InactiveProposalQueuePrefix
+	ProposalIDKey                = types.ProposalIDKey
+	DepositsKeyPrefix            = types.DepositsKeyPrefix
+	FundsKey                     = types.FundsKey
+	VotesKeyPrefix               = types.VotesKeyPrefix
+	ParamStoreKeyDepositParams   = types.ParamStoreKeyDepositParams
+	ParamStoreKeyVotingParams    = types.ParamStoreKeyVotingParams
+	ParamStoreKeyTallyParams     = types.ParamStoreKeyTallyParams
+	ParamStoreKeyExpeditedParams = types.ParamStoreKeyExpeditedParams
+
+	ParamStoreKeyDepositPolicyParams = types.ParamStoreKeyDepositPolicyParams
 )
 
 type (
@@ -136,8 +164,12 @@ type (
 	MsgSubmitProposal       = types.MsgSubmitProposal
 	MsgDeposit              = types.MsgDeposit
 	MsgVote                 = types.MsgVote
+	MsgVoteWeighted         = types.MsgVoteWeighted
 	DepositParams           = types.DepositParams
 	TallyParams             = types.TallyParams
+	ExpeditedParams         = types.ExpeditedParams
+	DepositPolicyParams     = types.DepositPolicyParams
+	ProposalTypeMinDeposit  = types.ProposalTypeMinDeposit
 	VotingParams            = types.VotingParams
 	Params                  = types.Params
 	Proposal                = types.Proposal
@@ -147,6 +179,8 @@ type (
 	TallyResult             = types.TallyResult
 	TextProposal            = types.TextProposal
 	SoftwareUpgradeProposal = types.SoftwareUpgradeProposal
+	ExecMsgsProposal        = types.ExecMsgsProposal
+	ExecResult              = types.ExecResult
 	QueryProposalParams     = types.QueryProposalParams
 	QueryDepositParams      = types.QueryDepositParams
 	QueryVoteParams         = types.QueryVoteParams
@@ -154,4 +188,6 @@ type (
 	Vote                    = types.Vote
 	Votes                   = types.Votes
 	VoteOption              = types.VoteOption
+	WeightedVoteOption      = types.WeightedVoteOption
+	WeightedVoteOptions     = types.WeightedVoteOptions
 )
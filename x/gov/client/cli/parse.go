@@ -7,9 +7,19 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/barkisnet/barkis/codec"
+	sdk "github.com/barkisnet/barkis/types"
 	govutils "github.com/barkisnet/barkis/x/gov/client/utils"
 )
 
+// ExecMsgsProposalJSON defines an ExecMsgsProposal with a deposit
+type ExecMsgsProposalJSON struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg `json:"msgs" yaml:"msgs"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+}
+
 func parseSubmitProposalFlags() (*proposal, error) {
 	proposal := &proposal{}
 	proposalFile := viper.GetString(FlagProposal)
@@ -40,3 +50,19 @@ func parseSubmitProposalFlags() (*proposal, error) {
 
 	return proposal, nil
 }
+
+// ParseExecMsgsProposalJSON reads and parses an ExecMsgsProposalJSON from a file.
+func ParseExecMsgsProposalJSON(cdc *codec.Codec, proposalFile string) (ExecMsgsProposalJSON, error) {
+	proposal := ExecMsgsProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
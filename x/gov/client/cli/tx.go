@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/barkisnet/barkis/client"
 	"github.com/barkisnet/barkis/client/context"
@@ -29,6 +30,7 @@ const (
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
 )
 
 type proposal struct {
@@ -63,6 +65,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	}
 
 	cmdSubmitProp := GetCmdSubmitProposal(cdc)
+	cmdSubmitProp.AddCommand(client.PostCommands(GetCmdSubmitExecMsgsProposal(cdc))[0])
 	for _, pcmd := range pcmds {
 		cmdSubmitProp.AddCommand(client.PostCommands(pcmd)[0])
 	}
@@ -70,6 +73,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(client.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -100,6 +104,9 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Pass --expedited to use the expedited voting period and threshold. An expedited proposal
+that does not reach the expedited threshold is converted into a regular proposal.
 `,
 				version.ClientName, version.ClientName,
 			),
@@ -121,6 +128,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 
 			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			msg.Expedited = viper.GetBool(FlagExpedited)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -134,6 +142,68 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.PersistentFlags().Bool(FlagExpedited, false, "submit the proposal as expedited, with a shorter voting period, a higher threshold and a higher min deposit")
+
+	return cmd
+}
+
+// GetCmdSubmitExecMsgsProposal implements submitting a proposal to execute msgs
+// signed by the governance module account.
+func GetCmdSubmitExecMsgsProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec-msgs [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to execute msgs signed by the governance module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to execute msgs along with an initial deposit.
+The msgs are executed once the proposal passes, and the governance module account
+must be the only signer of each of them. The proposal details must be supplied via
+a JSON file.
+
+Example:
+$ %s tx gov submit-proposal exec-msgs <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Send from the gov account",
+  "description": "Send some tokens held by the governance module account",
+  "msgs": [
+    {
+      "type": "cosmos-sdk/MsgSend",
+      "value": {
+        "from_address": "<gov_module_account_address>",
+        "to_address": "barkis1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+        "amount": [{"denom": "ubarkis", "amount": "10000"}]
+      }
+    }
+  ],
+  "deposit": [{"denom": "ubarkis", "amount": "10000"}]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseExecMsgsProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewExecMsgsProposal(proposal.Title, proposal.Description, proposal.Msgs)
+
+			msg := types.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			msg.Expedited = viper.GetBool(FlagExpedited)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
 
 	return cmd
 }
@@ -232,4 +302,53 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power across options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, splitting your voting power across
+several options. The weights of the options must sum up to 1. You can find the
+proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // DONTCOVER
@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/barkisnet/barkis/x/gov/types"
+import (
+	"strings"
+
+	"github.com/barkisnet/barkis/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +26,22 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize user specified weighted vote
+// options, eg. "yes=0.6,no=0.4"
+func NormalizeWeightedVoteOptions(options string) string {
+	pairs := strings.Split(options, ",")
+	for i, pair := range pairs {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		if len(fields) == 2 {
+			if option := NormalizeVoteOption(strings.TrimSpace(fields[0])); option != "" {
+				fields[0] = option
+			}
+		}
+		pairs[i] = strings.Join(fields, "=")
+	}
+	return strings.Join(pairs, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -51,7 +51,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.getMinDeposit(ctx, proposal)) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -76,6 +76,26 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return nil, activatedVotingPeriod
 }
 
+// validateInitialDeposit checks that the initial deposit of a proposal is at
+// least the min initial deposit ratio of its min deposit
+func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, proposal Proposal, initialDeposit sdk.Coins) sdk.Error {
+	ratio := keeper.GetDepositPolicyParams(ctx).MinInitialDepositRatio
+	if !ratio.IsPositive() {
+		return nil
+	}
+
+	minInitialDeposit := sdk.Coins{}
+	for _, coin := range keeper.getMinDeposit(ctx, proposal) {
+		amount := ratio.MulInt(coin.Amount).Ceil().TruncateInt()
+		minInitialDeposit = minInitialDeposit.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+	}
+
+	if !initialDeposit.IsAllGTE(minInitialDeposit) {
+		return ErrInsufficientInitialDeposit(keeper.codespace, initialDeposit, minInitialDeposit)
+	}
+	return nil
+}
+
 // GetAllDeposits returns all the deposits from the store
 func (keeper Keeper) GetAllDeposits(ctx sdk.Context) (deposits Deposits) {
 	keeper.IterateAllDeposits(ctx, func(deposit Deposit) bool {
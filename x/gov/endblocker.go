@@ -28,7 +28,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.getMinDeposit(ctx, proposal),
 				proposal.TotalDeposit,
 			),
 		)
@@ -41,6 +41,40 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 		passes, burnDeposits, tallyResults := tally(ctx, keeper, proposal)
 
+		// An expedited proposal which does not pass is converted to a normal
+		// proposal, keeping its votes and deposits, and is tallied again at the
+		// end of the normal voting period.
+		if proposal.Expedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+			proposal.Expedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: rejected, converted to a normal proposal ending at %s",
+					proposal.ProposalID, proposal.GetTitle(), proposal.VotingEndTime,
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		if keeper.hooks != nil && sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.GovVoteSlashingUpgrade) {
+			voters, nonVoters := keeper.GetValidatorParticipation(ctx, proposal.ProposalID)
+			keeper.hooks.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalID, voters, nonVoters)
+		}
+
+		keeper.deleteVotes(ctx, proposal.ProposalID)
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
 		} else {
@@ -54,8 +88,21 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.Content)
-			if err == nil {
+			var errLog string
+			if execProposal, ok := proposal.Content.(ExecMsgsProposal); ok {
+				// the msgs are executed by the keeper, and their result is
+				// stored on the proposal
+				res := keeper.executeMsgs(cacheCtx, execProposal.Msgs)
+				proposal.ExecResult = types.NewExecResult(res)
+				if res.IsOK() {
+					ctx.EventManager().EmitEvents(res.Events)
+				} else {
+					errLog = res.Log
+				}
+			} else if err := handler(cacheCtx, proposal.Content); err != nil {
+				errLog = err.ABCILog()
+			}
+			if errLog == "" {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
@@ -65,7 +112,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			} else {
 				proposal.Status = StatusFailed
 				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err.ABCILog())
+				logMsg = fmt.Sprintf("passed, but failed on execution: %s", errLog)
 			}
 		} else {
 			proposal.Status = StatusRejected
@@ -332,3 +332,179 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	EndBlocker(ctx, input.keeper)
 }
+
+func TestExpeditedProposalConvertedToNormal(t *testing.T) {
+	input := getMockApp(t, 3, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+	staking.EndBlocker(ctx, input.sk)
+
+	expeditedMinDeposit := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20))}
+	input.keeper.SetExpeditedParams(ctx, NewExpeditedParams(expeditedMinDeposit, time.Hour, sdk.NewDecWithPrec(667, 3)))
+
+	proposal, err := input.keeper.SubmitExpeditedProposal(ctx, testProposal())
+	require.NoError(t, err)
+	require.True(t, proposal.Expedited)
+	proposalID := proposal.ProposalID
+
+	// the normal min deposit is not enough to activate an expedited proposal
+	halfDeposit := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposalID, input.addrs[2], halfDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+	err, votingStarted = input.keeper.AddDeposit(ctx, proposalID, input.addrs[2], halfDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, proposal.VotingStartTime.Add(time.Hour), proposal.VotingEndTime)
+
+	// 60% yes passes the normal threshold but not the expedited one
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(input.keeper.GetVotingParams(ctx).VotingPeriod), proposal.VotingEndTime)
+	require.Len(t, input.keeper.GetVotes(ctx, proposalID), 2)
+	require.Len(t, input.keeper.GetDeposits(ctx, proposalID), 1)
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Empty(t, input.keeper.GetVotes(ctx, proposalID))
+}
+
+func TestExpeditedProposalPassed(t *testing.T) {
+	input := getMockApp(t, 3, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{7, 3})
+	staking.EndBlocker(ctx, input.sk)
+
+	expeditedMinDeposit := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20))}
+	input.keeper.SetExpeditedParams(ctx, NewExpeditedParams(expeditedMinDeposit, time.Hour, sdk.NewDecWithPrec(667, 3)))
+
+	proposal, err := input.keeper.SubmitExpeditedProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposalID, input.addrs[2], expeditedMinDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(time.Hour)
+	ctx = ctx.WithBlockHeader(newHeader)
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, proposal.Expedited)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Empty(t, input.keeper.GetDeposits(ctx, proposalID))
+}
+
+func TestExecMsgsProposal(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(input.addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	govAddr := input.keeper.GetGovernanceAccount(ctx).GetAddress()
+	minDeposit := input.keeper.GetDepositParams(ctx).MinDeposit
+
+	// msgs must be signed by the governance module account
+	_, err := input.keeper.SubmitProposal(ctx, NewExecMsgsProposal("Test", "description",
+		[]sdk.Msg{NewMsgVote(input.addrs[0], 1, OptionYes)}))
+	require.Error(t, err)
+
+	// the first proposal makes the governance module account vote on the text
+	// proposal, the second one fails as the proposal to vote on does not exist
+	textProposalID, err := input.keeper.GetProposalID(ctx)
+	require.NoError(t, err)
+	passing, err := input.keeper.SubmitProposal(ctx, NewExecMsgsProposal("Test", "description",
+		[]sdk.Msg{NewMsgVote(govAddr, textProposalID+2, OptionYes)}))
+	require.NoError(t, err)
+	failing, err := input.keeper.SubmitProposal(ctx, NewExecMsgsProposal("Test", "description",
+		[]sdk.Msg{NewMsgVote(govAddr, 100, OptionYes)}))
+	require.NoError(t, err)
+
+	for _, proposalID := range []uint64{passing.ProposalID, failing.ProposalID} {
+		_, votingStarted := input.keeper.AddDeposit(ctx, proposalID, input.addrs[1], minDeposit)
+		require.True(t, votingStarted)
+		require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes))
+	}
+
+	// the text proposal is still in its voting period when the others end
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	textProposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	require.Equal(t, textProposalID+2, textProposal.ProposalID)
+	_, votingStarted := input.keeper.AddDeposit(ctx, textProposal.ProposalID, input.addrs[1], minDeposit)
+	require.True(t, votingStarted)
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = passing.SubmitTime.Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok := input.keeper.GetProposal(ctx, passing.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.NotNil(t, proposal.ExecResult)
+	require.True(t, proposal.ExecResult.IsOK())
+	require.NotEmpty(t, proposal.ExecResult.Events)
+
+	vote, found := input.keeper.GetVote(ctx, textProposal.ProposalID, govAddr)
+	require.True(t, found)
+	require.Equal(t, OptionYes, vote.Option)
+
+	proposal, ok = input.keeper.GetProposal(ctx, failing.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusFailed, proposal.Status)
+	require.NotNil(t, proposal.ExecResult)
+	require.False(t, proposal.ExecResult.IsOK())
+	require.Equal(t, CodeUnknownProposal, proposal.ExecResult.Code)
+}
@@ -30,3 +30,10 @@ type StakingKeeper interface {
 	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingexported.DelegationI) (stop bool))
 }
+
+// GovHooks event hooks for governance proposals
+type GovHooks interface {
+	// Must be called when the voting period of a proposal ends, with the bonded
+	// validators which voted on it and the ones which did not
+	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64, voters, nonVoters []sdk.ValAddress)
+}
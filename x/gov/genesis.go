@@ -23,6 +23,12 @@ type GenesisState struct {
 	DepositParams      DepositParams `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams        TallyParams   `json:"tally_params" yaml:"tally_params"`
+
+	ExpeditedParams ExpeditedParams `json:"expedited_params" yaml:"expedited_params"`
+
+	DepositPolicyParams DepositPolicyParams `json:"deposit_policy_params" yaml:"deposit_policy_params"`
+
+	Funds sdk.Coins `json:"funds,omitempty" yaml:"funds,omitempty"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -52,6 +58,8 @@ func DefaultGenesisState() GenesisState {
 			Threshold: sdk.NewDecWithPrec(5, 1),
 			Veto:      sdk.NewDecWithPrec(334, 3),
 		},
+		ExpeditedParams:     types.DefaultExpeditedParams(),
+		DepositPolicyParams: types.DefaultDepositPolicyParams(),
 	}
 }
 
@@ -87,6 +95,25 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	// expedited params may be left empty, the default ones are used then
+	if !data.ExpeditedParams.Threshold.IsNil() {
+		err := data.ExpeditedParams.ValidateStricterThan(data.DepositParams, data.VotingParams, data.TallyParams)
+		if err != nil {
+			return fmt.Errorf("Governance expedited params are invalid: %s", err)
+		}
+	}
+
+	// deposit policy params may be left empty, the default ones are used then
+	if !data.DepositPolicyParams.MinInitialDepositRatio.IsNil() {
+		if err := data.DepositPolicyParams.Validate(); err != nil {
+			return fmt.Errorf("Governance deposit policy params are invalid: %s", err)
+		}
+	}
+
+	if !data.Funds.IsValid() {
+		return fmt.Errorf("Governance funds must be a valid sdk.Coins amount, is %s", data.Funds.String())
+	}
+
 	return nil
 }
 
@@ -97,6 +124,12 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data Gene
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetVotingParams(ctx, data.VotingParams)
 	k.SetTallyParams(ctx, data.TallyParams)
+	if !data.ExpeditedParams.Threshold.IsNil() {
+		k.SetExpeditedParams(ctx, data.ExpeditedParams)
+	}
+	if !data.DepositPolicyParams.MinInitialDepositRatio.IsNil() {
+		k.SetDepositPolicyParams(ctx, data.DepositPolicyParams)
+	}
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -124,9 +157,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data Gene
 		k.SetProposal(ctx, proposal)
 	}
 
+	k.setFunds(ctx, data.Funds)
+
 	// add coins if not provided on genesis
 	if moduleAcc.GetCoins().IsZero() {
-		if err := moduleAcc.SetCoins(totalDeposits); err != nil {
+		if err := moduleAcc.SetCoins(totalDeposits.Add(data.Funds)); err != nil {
 			panic(err)
 		}
 		supplyKeeper.SetModuleAccount(ctx, moduleAcc)
@@ -139,6 +174,8 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	expeditedParams := k.GetExpeditedParams(ctx)
+	depositPolicyParams := k.GetDepositPolicyParams(ctx)
 
 	proposals := k.GetProposalsFiltered(ctx, nil, nil, StatusNil, 0)
 
@@ -160,5 +197,10 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		ExpeditedParams:    expeditedParams,
+
+		DepositPolicyParams: depositPolicyParams,
+
+		Funds: k.GetFunds(ctx),
 	}
 }
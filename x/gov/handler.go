@@ -22,6 +22,9 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -30,11 +33,31 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
-	proposal, err := keeper.SubmitProposal(ctx, msg.Content)
+	if _, ok := msg.Content.(ExecMsgsProposal); ok && !sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.ExecMsgsProposalUpgrade) {
+		return sdk.ErrUnknownRequest("exec msgs proposals are not enabled yet").Result()
+	}
+	if proposalType := msg.Content.ProposalType(); !IsProposalTypeEnabled(proposalType) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("%s proposals are not enabled yet", proposalType)).Result()
+	}
+
+	var proposal Proposal
+	var err sdk.Error
+	if msg.Expedited {
+		if !sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.ExpeditedProposalUpgrade) {
+			return sdk.ErrUnknownRequest("expedited proposals are not enabled yet").Result()
+		}
+		proposal, err = keeper.SubmitExpeditedProposal(ctx, msg.Content)
+	} else {
+		proposal, err = keeper.SubmitProposal(ctx, msg.Content)
+	}
 	if err != nil {
 		return err.Result()
 	}
 
+	if err := keeper.validateInitialDeposit(ctx, proposal, msg.InitialDeposit); err != nil {
+		return err.Result()
+	}
+
 	err, votingStarted := keeper.AddDeposit(ctx, proposal.ProposalID, msg.Proposer, msg.InitialDeposit)
 	if err != nil {
 		return err.Result()
@@ -106,3 +129,20 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 	return sdk.Result{Events: ctx.EventManager().Events()}
 
 }
+
+func handleMsgVoteWeighted(ctx sdk.Context, keeper Keeper, msg MsgVoteWeighted) sdk.Result {
+	err := keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -3,6 +3,7 @@ package gov
 import (
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/barkisnet/barkis/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -18,3 +19,79 @@ func TestInvalidMsg(t *testing.T) {
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "unrecognized gov message type"))
 }
+
+func TestSubmitProposalDepositPolicy(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	handler := NewHandler(input.keeper)
+
+	textMinDeposit := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20))}
+	input.keeper.SetDepositPolicyParams(ctx, NewDepositPolicyParams(
+		[]ProposalTypeMinDeposit{NewProposalTypeMinDeposit(ProposalTypeText, textMinDeposit)},
+		true, false, sdk.NewDecWithPrec(25, 2),
+	))
+
+	// the initial deposit is lower than a quarter of the text proposal min deposit
+	deposit := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4))}
+	res := handler(ctx, NewMsgSubmitProposal(testProposal(), deposit, input.addrs[0]))
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeInsufficientDeposit, res.Code)
+
+	// the default min deposit applies to the other proposal types
+	res = handler(ctx, NewMsgSubmitProposal(NewSoftwareUpgradeProposal("Test", "description"), deposit, input.addrs[0]))
+	require.True(t, res.IsOK())
+
+	deposit = sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+	res = handler(ctx, NewMsgSubmitProposal(testProposal(), deposit, input.addrs[0]))
+	require.True(t, res.IsOK())
+
+	var proposalID uint64
+	input.keeper.cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusDepositPeriod, proposal.Status)
+
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposalID, input.addrs[0], deposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+}
+
+func TestExpeditedProposalDepositPolicy(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	// the text proposals have their own min deposit, higher than the expedited one
+	expeditedMinDeposit := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20))}
+	input.keeper.SetExpeditedParams(ctx, NewExpeditedParams(expeditedMinDeposit, time.Hour, sdk.NewDecWithPrec(667, 3)))
+	textMinDeposit := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(30))}
+	input.keeper.SetDepositPolicyParams(ctx, NewDepositPolicyParams(
+		[]ProposalTypeMinDeposit{NewProposalTypeMinDeposit(ProposalTypeText, textMinDeposit)},
+		true, false, sdk.ZeroDec(),
+	))
+
+	proposal, err := input.keeper.SubmitExpeditedProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	// the expedited min deposit does not start the voting period
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposalID, input.addrs[0], expeditedMinDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	deposit := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+	err, votingStarted = input.keeper.AddDeposit(ctx, proposalID, input.addrs[0], deposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, proposal.Expedited)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(time.Hour), proposal.VotingEndTime)
+}
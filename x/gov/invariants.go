@@ -20,7 +20,7 @@ func AllInvariants(keeper Keeper) sdk.Invariant {
 }
 
 // ModuleAccountInvariant checks that the module account coins reflects the sum of
-// deposit amounts held on store
+// deposit amounts held on store and the funds owned by the module account
 func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var expectedDeposits sdk.Coins
@@ -30,11 +30,12 @@ func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 			return false
 		})
 
+		funds := keeper.GetFunds(ctx)
 		macc := keeper.GetGovernanceAccount(ctx)
-		broken := !macc.GetCoins().IsEqual(expectedDeposits)
+		broken := !macc.GetCoins().IsEqual(expectedDeposits.Add(funds))
 
 		return sdk.FormatInvariant(types.ModuleName, "deposits",
-			fmt.Sprintf("\tgov ModuleAccount coins: %s\n\tsum of deposit amounts:  %s\n",
-				macc.GetCoins(), expectedDeposits)), broken
+			fmt.Sprintf("\tgov ModuleAccount coins: %s\n\tsum of deposit amounts:  %s\n\tgov funds:               %s\n",
+				macc.GetCoins(), expectedDeposits, funds)), broken
 	}
 }
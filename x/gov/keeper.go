@@ -38,6 +38,12 @@ type Keeper struct {
 
 	// Proposal router
 	router Router
+
+	// Msg router, to execute the msgs of an ExecMsgsProposal
+	msgRouter sdk.Router
+
+	// Hooks called when the voting period of a proposal ends
+	hooks GovHooks
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -45,9 +51,11 @@ type Keeper struct {
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
+//
+// The msg router is used to execute the msgs of a passed ExecMsgsProposal.
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper, paramSpace params.Subspace,
-	supplyKeeper SupplyKeeper, sk StakingKeeper, codespace sdk.CodespaceType, rtr Router,
+	supplyKeeper SupplyKeeper, sk StakingKeeper, codespace sdk.CodespaceType, rtr Router, msgRouter sdk.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -60,7 +68,7 @@ func NewKeeper(
 	// could create invalid or non-deterministic behavior.
 	rtr.Seal()
 
-	return Keeper{
+	keeper := Keeper{
 		storeKey:     key,
 		paramsKeeper: paramsKeeper,
 		paramSpace:   paramSpace.WithKeyTable(ParamKeyTable()),
@@ -69,7 +77,28 @@ func NewKeeper(
 		cdc:          cdc,
 		codespace:    codespace,
 		router:       rtr,
+		msgRouter:    msgRouter,
+	}
+
+	// the expedited params are checked against the other params of the
+	// subspace, so their validator is registered once the subspace is set,
+	// along with the one of the deposit policy params
+	keeper.paramSpace = keeper.paramSpace.UpdateKeyTable(params.NewKeyTable().
+		RegisterType(ParamStoreKeyExpeditedParams, ExpeditedParams{}).
+		RegisterValidator(ParamStoreKeyExpeditedParams, keeper.validateExpeditedParams).
+		RegisterType(ParamStoreKeyDepositPolicyParams, DepositPolicyParams{}).
+		RegisterValidator(ParamStoreKeyDepositPolicyParams, validateDepositPolicyParams))
+
+	return keeper
+}
+
+// SetHooks sets the governance hooks
+func (keeper *Keeper) SetHooks(gh GovHooks) *Keeper {
+	if keeper.hooks != nil {
+		panic("cannot set governance hooks twice")
 	}
+	keeper.hooks = gh
+	return keeper
 }
 
 // Logger returns a module-specific logger.
@@ -82,6 +111,27 @@ func (keeper Keeper) GetGovernanceAccount(ctx sdk.Context) exported.ModuleAccoun
 	return keeper.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
 }
 
+// GetFunds returns the coins owned by the governance module account itself,
+// apart from the deposits it holds
+func (keeper Keeper) GetFunds(ctx sdk.Context) (funds sdk.Coins) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.FundsKey)
+	if bz == nil {
+		return nil
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &funds)
+	return funds
+}
+
+func (keeper Keeper) setFunds(ctx sdk.Context, funds sdk.Coins) {
+	store := ctx.KVStore(keeper.storeKey)
+	if funds.Empty() {
+		store.Delete(types.FundsKey)
+		return
+	}
+	store.Set(types.FundsKey, keeper.cdc.MustMarshalBinaryLengthPrefixed(funds))
+}
+
 // Params
 
 // Returns the current DepositParams from the global param store
@@ -105,6 +155,22 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) TallyParams {
 	return tallyParams
 }
 
+// Returns the current ExpeditedParams from the global param store, or the
+// default ones if they have not been set yet
+func (keeper Keeper) GetExpeditedParams(ctx sdk.Context) ExpeditedParams {
+	expeditedParams := types.DefaultExpeditedParams()
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyExpeditedParams, &expeditedParams)
+	return expeditedParams
+}
+
+// Returns the current DepositPolicyParams from the global param store, or the
+// default ones if they have not been set yet
+func (keeper Keeper) GetDepositPolicyParams(ctx sdk.Context) DepositPolicyParams {
+	depositPolicyParams := types.DefaultDepositPolicyParams()
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyDepositPolicyParams, &depositPolicyParams)
+	return depositPolicyParams
+}
+
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams DepositParams) {
 	keeper.paramSpace.Set(ctx, ParamStoreKeyDepositParams, &depositParams)
 }
@@ -117,6 +183,64 @@ func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams TallyParams) {
 	keeper.paramSpace.Set(ctx, ParamStoreKeyTallyParams, &tallyParams)
 }
 
+func (keeper Keeper) SetExpeditedParams(ctx sdk.Context, expeditedParams ExpeditedParams) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyExpeditedParams, &expeditedParams)
+}
+
+func (keeper Keeper) SetDepositPolicyParams(ctx sdk.Context, depositPolicyParams DepositPolicyParams) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyDepositPolicyParams, &depositPolicyParams)
+}
+
+// validateExpeditedParams checks that expedited params set by a parameter
+// change are stricter than the current params of regular proposals
+func (keeper Keeper) validateExpeditedParams(ctx sdk.Context, i interface{}) error {
+	expeditedParams, ok := i.(ExpeditedParams)
+	if !ok {
+		return fmt.Errorf("invalid expedited params type: %T", i)
+	}
+	return expeditedParams.ValidateStricterThan(keeper.GetDepositParams(ctx), keeper.GetVotingParams(ctx),
+		keeper.GetTallyParams(ctx))
+}
+
+// validateDepositPolicyParams checks that deposit policy params set by a
+// parameter change are within valid ranges
+func validateDepositPolicyParams(_ sdk.Context, i interface{}) error {
+	depositPolicyParams, ok := i.(DepositPolicyParams)
+	if !ok {
+		return fmt.Errorf("invalid deposit policy params type: %T", i)
+	}
+	return depositPolicyParams.Validate()
+}
+
+// get the minimum deposit for a proposal to enter voting period. An expedited
+// proposal needs the larger of the expedited and the regular minimum deposit
+// of its type for each denom.
+func (keeper Keeper) getMinDeposit(ctx sdk.Context, proposal Proposal) sdk.Coins {
+	minDeposit, ok := keeper.GetDepositPolicyParams(ctx).MinDepositOf(proposal.ProposalType())
+	if !ok {
+		minDeposit = keeper.GetDepositParams(ctx).MinDeposit
+	}
+	if !proposal.Expedited {
+		return minDeposit
+	}
+
+	expeditedMinDeposit := keeper.GetExpeditedParams(ctx).MinDeposit
+	maxDeposit := sdk.NewCoins()
+	for _, coin := range minDeposit.Add(expeditedMinDeposit) {
+		amount := sdk.MaxInt(minDeposit.AmountOf(coin.Denom), expeditedMinDeposit.AmountOf(coin.Denom))
+		maxDeposit = maxDeposit.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+	}
+	return maxDeposit
+}
+
+// get the length of the voting period of a proposal
+func (keeper Keeper) getVotingPeriod(ctx sdk.Context, proposal Proposal) time.Duration {
+	if proposal.Expedited {
+		return keeper.GetExpeditedParams(ctx).VotingPeriod
+	}
+	return keeper.GetVotingParams(ctx).VotingPeriod
+}
+
 // ProposalQueues
 
 // InsertActiveProposalQueue inserts a ProposalID into the active proposal queue at endTime
@@ -9,6 +9,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/barkisnet/barkis/baseapp"
 	"github.com/barkisnet/barkis/codec"
 	sdk "github.com/barkisnet/barkis/types"
 )
@@ -354,3 +355,136 @@ func TestSubmitProposal(t *testing.T) {
 		require.Equal(t, tc.expectedErr, err, "unexpected type of error: %s", err)
 	}
 }
+
+func TestGetMinDeposit(t *testing.T) {
+	input := getMockApp(t, 0, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	textMinDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)),
+		sdk.NewCoin("atom", sdk.NewInt(10)))
+	input.keeper.SetDepositPolicyParams(ctx, NewDepositPolicyParams(
+		[]ProposalTypeMinDeposit{NewProposalTypeMinDeposit(ProposalTypeText, textMinDeposit)},
+		true, false, sdk.ZeroDec()))
+	expeditedMinDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(50)),
+		sdk.NewCoin("btc", sdk.NewInt(5)))
+	input.keeper.SetExpeditedParams(ctx, NewExpeditedParams(expeditedMinDeposit, time.Hour, sdk.NewDecWithPrec(667, 3)))
+
+	proposal := NewProposal(testProposal(), 1, time.Now(), time.Now())
+	require.Equal(t, textMinDeposit, input.keeper.getMinDeposit(ctx, proposal))
+
+	// the larger amount of each denom is required from an expedited proposal
+	proposal.Expedited = true
+	expected := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)),
+		sdk.NewCoin("atom", sdk.NewInt(10)), sdk.NewCoin("btc", sdk.NewInt(5)))
+	require.Equal(t, expected, input.keeper.getMinDeposit(ctx, proposal))
+}
+
+func TestUpdateExpeditedParams(t *testing.T) {
+	input := getMockApp(t, 0, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	depositParams := input.keeper.GetDepositParams(ctx)
+	votingParams := input.keeper.GetVotingParams(ctx)
+	tallyParams := input.keeper.GetTallyParams(ctx)
+
+	tests := []struct {
+		name       string
+		params     ExpeditedParams
+		expectPass bool
+	}{
+		{"stricter", NewExpeditedParams(depositParams.MinDeposit.Add(depositParams.MinDeposit),
+			votingParams.VotingPeriod/2, tallyParams.Threshold.Add(sdk.NewDecWithPrec(1, 1))), true},
+		{"same deposit and threshold", NewExpeditedParams(depositParams.MinDeposit,
+			votingParams.VotingPeriod/2, tallyParams.Threshold), true},
+		{"lower deposit", NewExpeditedParams(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+			votingParams.VotingPeriod/2, tallyParams.Threshold), false},
+		{"longer voting period", NewExpeditedParams(depositParams.MinDeposit,
+			votingParams.VotingPeriod, tallyParams.Threshold), false},
+		{"lower threshold", NewExpeditedParams(depositParams.MinDeposit,
+			votingParams.VotingPeriod/2, tallyParams.Threshold.Sub(sdk.NewDecWithPrec(1, 1))), false},
+	}
+
+	for _, tc := range tests {
+		bz := input.mApp.Cdc.MustMarshalJSON(tc.params)
+		err := input.keeper.paramSpace.Update(ctx, ParamStoreKeyExpeditedParams, bz)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.params, input.keeper.GetExpeditedParams(ctx), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.NotEqual(t, tc.params, input.keeper.GetExpeditedParams(ctx), tc.name)
+		}
+	}
+}
+
+func TestUpdateDepositPolicyParams(t *testing.T) {
+	input := getMockApp(t, 0, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	minDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)))
+
+	tests := []struct {
+		name       string
+		params     DepositPolicyParams
+		expectPass bool
+	}{
+		{"valid", NewDepositPolicyParams([]ProposalTypeMinDeposit{NewProposalTypeMinDeposit(ProposalTypeText, minDeposit)},
+			true, true, sdk.NewDecWithPrec(25, 2)), true},
+		{"invalid proposal type", NewDepositPolicyParams([]ProposalTypeMinDeposit{NewProposalTypeMinDeposit("Unknown", minDeposit)},
+			true, true, sdk.NewDecWithPrec(25, 2)), false},
+		{"invalid min deposit", NewDepositPolicyParams([]ProposalTypeMinDeposit{NewProposalTypeMinDeposit(ProposalTypeText,
+			sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}})}, true, true, sdk.NewDecWithPrec(25, 2)), false},
+		{"negative ratio", NewDepositPolicyParams(nil, true, true, sdk.NewDecWithPrec(-1, 2)), false},
+		{"ratio above one", NewDepositPolicyParams(nil, true, true, sdk.NewDecWithPrec(101, 2)), false},
+	}
+
+	for _, tc := range tests {
+		bz := input.mApp.Cdc.MustMarshalJSON(tc.params)
+		err := input.keeper.paramSpace.Update(ctx, ParamStoreKeyDepositPolicyParams, bz)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.params, input.keeper.GetDepositPolicyParams(ctx), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.NotEqual(t, tc.params, input.keeper.GetDepositPolicyParams(ctx), tc.name)
+		}
+	}
+
+	// a change leaving out the ratio is rejected instead of storing a nil ratio
+	bz := []byte(`{"min_deposits":[],"burn_on_quorum_failure":true,"burn_on_reject":false}`)
+	require.Error(t, input.keeper.paramSpace.Update(ctx, ParamStoreKeyDepositPolicyParams, bz))
+	require.False(t, input.keeper.GetDepositPolicyParams(ctx).MinInitialDepositRatio.IsNil())
+}
+
+func TestExecuteMsgsEvents(t *testing.T) {
+	input := getMockApp(t, 0, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	govAddr := input.keeper.GetGovernanceAccount(ctx).GetAddress()
+
+	// a handler which emits its events on the event manager it is given
+	keeper := input.keeper
+	keeper.msgRouter = baseapp.NewRouter().AddRoute("TestMsg", func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx.EventManager().EmitEvent(sdk.NewEvent("test"))
+		return sdk.Result{Events: ctx.EventManager().Events()}
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res := keeper.executeMsgs(ctx, []sdk.Msg{sdk.NewTestMsg(govAddr), sdk.NewTestMsg(govAddr)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.Events{sdk.NewEvent("test"), sdk.NewEvent("test")}, res.Events)
+	require.Empty(t, ctx.EventManager().Events())
+}
@@ -9,17 +9,35 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal, sdk.Error) {
+	return keeper.submitProposal(ctx, content, false)
+}
+
+// SubmitExpeditedProposal create new proposal given a content, which has the
+// expedited min deposit, voting period and threshold
+func (keeper Keeper) SubmitExpeditedProposal(ctx sdk.Context, content Content) (Proposal, sdk.Error) {
+	return keeper.submitProposal(ctx, content, true)
+}
+
+func (keeper Keeper) submitProposal(ctx sdk.Context, content Content, expedited bool) (Proposal, sdk.Error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return Proposal{}, ErrNoProposalHandlerExists(keeper.codespace, content)
 	}
 
-	// Execute the proposal content in a cache-wrapped context to validate the
-	// actual parameter changes before the proposal proceeds through the
-	// governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return Proposal{}, ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
+	if execProposal, ok := content.(ExecMsgsProposal); ok {
+		// The msgs are executed against the state at the end of the voting
+		// period, so only their signers are validated here.
+		if err := keeper.validateExecMsgsSigners(ctx, execProposal.Msgs); err != nil {
+			return Proposal{}, err
+		}
+	} else {
+		// Execute the proposal content in a cache-wrapped context to validate the
+		// actual parameter changes before the proposal proceeds through the
+		// governance process. State is not persisted.
+		cacheCtx, _ := ctx.CacheContext()
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content); err != nil {
+			return Proposal{}, ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -31,6 +49,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal,
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
 	proposal := NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal.Expedited = expedited
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -46,6 +65,67 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal,
 	return proposal, nil
 }
 
+// validateExecMsgsSigners checks that the governance module account is the only
+// signer of every msg of an ExecMsgsProposal.
+func (keeper Keeper) validateExecMsgsSigners(ctx sdk.Context, msgs []sdk.Msg) sdk.Error {
+	govAddr := keeper.supplyKeeper.GetModuleAddress(types.ModuleName)
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return ErrInvalidProposalContent(keeper.codespace,
+				fmt.Sprintf("msg %d must be signed by the governance module account %s only", i, govAddr))
+		}
+	}
+	return nil
+}
+
+// executeMsgs executes the msgs of a passed ExecMsgsProposal through the app's
+// msg router, with the governance module account as their signer. It stops at
+// the first msg which fails.
+//
+// The coins the msgs move into the governance module account are kept as its
+// funds, apart from the deposits, and a msg fails if it spends more than them.
+func (keeper Keeper) executeMsgs(ctx sdk.Context, msgs []sdk.Msg) sdk.Result {
+	if err := keeper.validateExecMsgsSigners(ctx, msgs); err != nil {
+		return err.Result()
+	}
+
+	funds := keeper.GetFunds(ctx)
+	var data []byte
+	var events sdk.Events
+	for _, msg := range msgs {
+		if !sdk.GlobalUpgradeMgr.MsgCheck(msg.Type()) {
+			return sdk.ErrMsgNotSupported(fmt.Sprintf("%s will be supported after height %d",
+				msg.Type(), sdk.GlobalUpgradeMgr.GetMsgHeight(msg.Type()))).Result()
+		}
+
+		handler := keeper.msgRouter.Route(msg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest("unrecognized message route: " + msg.Route()).Result()
+		}
+
+		// each msg gets its own event manager, so the events of the previous
+		// msgs are not returned again with its result
+		coins := keeper.GetGovernanceAccount(ctx).GetCoins()
+		res := handler(ctx.WithEventManager(sdk.NewEventManager()), msg)
+		if !res.IsOK() {
+			return res
+		}
+
+		newFunds, negative := funds.Add(keeper.GetGovernanceAccount(ctx).GetCoins()).SafeSub(coins)
+		if negative {
+			return ErrInsufficientFunds(keeper.codespace, funds).Result()
+		}
+		funds = newFunds
+
+		data = append(data, res.Data...)
+		events = events.AppendEvents(res.Events)
+	}
+
+	keeper.setFunds(ctx, funds)
+	return sdk.Result{Data: data, Events: events}
+}
+
 // GetProposal get Proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal Proposal, ok bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -152,7 +232,7 @@ func (keeper Keeper) setProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.getVotingPeriod(ctx, proposal)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -602,3 +602,112 @@ func TestTallyJailedValidator(t *testing.T) {
 	require.False(t, burnDeposits)
 	require.False(t, tallyResults.Equals(EmptyTallyResult()))
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:3]))
+	for i, addr := range input.addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6, 7})
+	staking.EndBlocker(ctx, input.sk)
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	delegator1Msg := staking.NewMsgDelegate(input.addrs[3], sdk.ValAddress(input.addrs[2]), sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	stakingHandler(ctx, delegator1Msg)
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// invalid weights are rejected
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[1], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+	})
+	require.NotNil(t, err)
+
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	require.Nil(t, err)
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[1], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+	})
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionNo)
+	require.Nil(t, err)
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[3], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	})
+	require.Nil(t, err)
+
+	vote, found := input.keeper.GetVote(ctx, proposalID, input.addrs[3])
+	require.True(t, found)
+	require.Len(t, vote.Options, 2)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+
+	// yes: 5 + 6 * 0.5 + 30 * 0.6, no: 6 * 0.5 + 30 * 0.4 + 7, up to the share truncation
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.InDelta(t, sdk.TokensFromConsensusPower(26).Int64(), tallyResults.Yes.Int64(), 1)
+	require.InDelta(t, sdk.TokensFromConsensusPower(22).Int64(), tallyResults.No.Int64(), 1)
+}
+
+func TestTallyDepositPolicy(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:2]))
+	for i, addr := range input.addrs[:2] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{2, 6})
+	staking.EndBlocker(ctx, input.sk)
+
+	input.keeper.SetDepositPolicyParams(ctx, NewDepositPolicyParams(nil, false, true, sdk.ZeroDec()))
+
+	// no quorum
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+
+	passes, burnDeposits, _ := tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+
+	// rejected without veto
+	proposal, err = input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[1], OptionNo))
+
+	passes, burnDeposits, _ = tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.True(t, burnDeposits)
+}
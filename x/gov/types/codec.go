@@ -7,17 +7,25 @@ import (
 // module codec
 var ModuleCdc = codec.New()
 
+// amino names which are also used in the sign bytes of ExecMsgsProposals
+const (
+	msgSubmitProposalName = "cosmos-sdk/MsgSubmitProposal"
+	execMsgsProposalName  = "cosmos-sdk/ExecMsgsProposal"
+)
+
 // RegisterCodec registers all the necessary types and interfaces for
 // governance.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*Content)(nil), nil)
 
-	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitProposal{}, msgSubmitProposalName, nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
 
 	cdc.RegisterConcrete(TextProposal{}, "cosmos-sdk/TextProposal", nil)
 	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(ExecMsgsProposal{}, execMsgsProposalName, nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
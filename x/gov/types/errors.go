@@ -21,6 +21,8 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeInsufficientDeposit      sdk.CodeType = 12
+	CodeInsufficientFunds        sdk.CodeType = 13
 )
 
 func ErrUnknownProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
@@ -55,6 +57,10 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption.String()))
 }
 
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("invalid weighted vote: %s", msg))
+}
+
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
@@ -62,3 +68,13 @@ func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}) sdk.Error {
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf("'%T' does not have a corresponding handler", content))
 }
+
+func ErrInsufficientInitialDeposit(codespace sdk.CodespaceType, initialDeposit, minInitialDeposit sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientDeposit,
+		fmt.Sprintf("initial deposit %s is lower than the min initial deposit %s", initialDeposit, minInitialDeposit))
+}
+
+func ErrInsufficientFunds(codespace sdk.CodespaceType, funds sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientFunds,
+		fmt.Sprintf("the governance module account only owns %s apart from the deposits", funds))
+}
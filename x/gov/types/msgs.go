@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	sdk "github.com/barkisnet/barkis/types"
@@ -10,20 +11,22 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}
 
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
 	Content        Content        `json:"content" yaml:"content"`
-	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
-	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"`         //  Initial deposit paid by sender. Must be strictly positive
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`                       //  Address of the proposer
+	Expedited      bool           `json:"expedited,omitempty" yaml:"expedited,omitempty"` //  Whether the proposal is expedited
 }
 
 func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+	return MsgSubmitProposal{content, initialDeposit, proposer, false}
 }
 
 //nolint
@@ -61,15 +64,52 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Content:         %s
   Initial Deposit: %s
-`, msg.Content.String(), msg.InitialDeposit)
+  Expedited:       %t
+`, msg.Content.String(), msg.InitialDeposit, msg.Expedited)
 }
 
 // Implements Msg.
 func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	if execProposal, ok := msg.Content.(ExecMsgsProposal); ok {
+		return msg.execMsgsSignBytes(execProposal)
+	}
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
 
+// execMsgsSignBytes returns the sign bytes of a msg submitting an
+// ExecMsgsProposal. The msgs to execute belong to other modules, whose types
+// are not registered on the module codec, so the sign bytes of each msg are
+// embedded in the amino JSON layout of the proposal instead.
+func (msg MsgSubmitProposal) execMsgsSignBytes(execProposal ExecMsgsProposal) []byte {
+	msgs := make([]json.RawMessage, len(execProposal.Msgs))
+	for i, execMsg := range execProposal.Msgs {
+		msgs[i] = json.RawMessage(execMsg.GetSignBytes())
+	}
+
+	type typedValue struct {
+		Type  string      `json:"type"`
+		Value interface{} `json:"value"`
+	}
+	content := struct {
+		Title       string            `json:"title"`
+		Description string            `json:"description"`
+		Msgs        []json.RawMessage `json:"msgs"`
+	}{execProposal.Title, execProposal.Description, msgs}
+	signDoc := typedValue{msgSubmitProposalName, struct {
+		Content        typedValue     `json:"content"`
+		InitialDeposit sdk.Coins      `json:"initial_deposit"`
+		Proposer       sdk.AccAddress `json:"proposer"`
+		Expedited      bool           `json:"expedited,omitempty"`
+	}{typedValue{execMsgsProposalName, content}, msg.InitialDeposit, msg.Proposer, msg.Expedited}}
+
+	bz, err := json.Marshal(signDoc)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
 // Implements Msg.
 func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Proposer}
@@ -170,3 +210,49 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  options chosen by the voter with their weights
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+func (msg MsgVoteWeighted) Type() string  { return TypeMsgVoteWeighted }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if err := ValidWeightedVoteOptions(msg.Options); err != nil {
+		return ErrInvalidWeightedVote(DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
@@ -117,3 +117,64 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.OneDec())}, true},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}, true},
+		{sdk.AccAddress{}, WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.OneDec())}, false},
+		{addrs[0], WeightedVoteOptions{}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(VoteOption(0x13), sdk.OneDec())}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, 0, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6, No=0.4")
+	require.NoError(t, err)
+	require.Equal(t, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}, options)
+
+	_, err = WeightedVoteOptionsFromString("Yes")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Maybe=1")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=x")
+	require.Error(t, err)
+}
+
+func TestMsgSubmitProposalGetSignBytes(t *testing.T) {
+	msg := NewMsgSubmitProposal(NewTextProposal("Test", "description"), coinsPos, addrs[0])
+	textSignBytes := msg.GetSignBytes()
+
+	// the sign bytes of an ExecMsgsProposal have the same layout, with the sign
+	// bytes of msgs whose types are not registered on the module codec
+	msg.Content = NewExecMsgsProposal("Test", "description", []sdk.Msg{sdk.NewTestMsg(addrs[1])})
+	msg.Expedited = true
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/ExecMsgsProposal","value":{"description":"description","msgs":[["barkis1w3jhxapjw4arg6"]],"title":"Test"}},"expedited":true,"initial_deposit":[{"amount":"1000","denom":"ubarkis"}],"proposer":"barkis1w3jhxap3qxg4x9"}}`
+	require.Equal(t, expected, string(res))
+	require.Equal(t, string(textSignBytes), strings.Replace(strings.Replace(expected,
+		`"cosmos-sdk/ExecMsgsProposal","value":{"description":"description","msgs":[["barkis1w3jhxapjw4arg6"]]`,
+		`"cosmos-sdk/TextProposal","value":{"description":"description"`, 1), `"expedited":true,`, "", 1))
+}
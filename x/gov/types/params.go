@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/barkisnet/barkis/types"
@@ -13,6 +14,9 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyExpeditedParams     = []byte("expeditedparams")
+	ParamStoreKeyDepositPolicyParams = []byte("depositpolicyparams")
 )
 
 // Key declaration for parameters
@@ -21,9 +25,14 @@ func ParamKeyTable() params.KeyTable {
 		ParamStoreKeyDepositParams, DepositParams{},
 		ParamStoreKeyVotingParams, VotingParams{},
 		ParamStoreKeyTallyParams, TallyParams{},
+		ParamStoreKeyExpeditedParams, ExpeditedParams{},
+		ParamStoreKeyDepositPolicyParams, DepositPolicyParams{},
 	)
 }
 
+// Default voting period of expedited proposals
+const DefaultExpeditedPeriod time.Duration = 3600 * 6 * time.Second // 6 hours
+
 // Param around deposits for governance
 type DepositParams struct {
 	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
@@ -90,22 +99,177 @@ func (vp VotingParams) String() string {
   Voting Period:      %s`, vp.VotingPeriod)
 }
 
+// Param around expedited proposals in governance
+type ExpeditedParams struct {
+	MinDeposit   sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`     //  Minimum deposit for an expedited proposal to enter voting period.
+	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period of an expedited proposal.
+	Threshold    sdk.Dec       `json:"threshold,omitempty" yaml:"threshold,omitempty"`         //  Minimum proportion of Yes votes for an expedited proposal to pass.
+}
+
+// NewExpeditedParams creates a new ExpeditedParams object
+func NewExpeditedParams(minDeposit sdk.Coins, votingPeriod time.Duration, threshold sdk.Dec) ExpeditedParams {
+	return ExpeditedParams{
+		MinDeposit:   minDeposit,
+		VotingPeriod: votingPeriod,
+		Threshold:    threshold,
+	}
+}
+
+// DefaultExpeditedParams returns the expedited params used until they are set
+func DefaultExpeditedParams() ExpeditedParams {
+	return ExpeditedParams{
+		MinDeposit:   sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(50))},
+		VotingPeriod: DefaultExpeditedPeriod,
+		Threshold:    sdk.NewDecWithPrec(667, 3),
+	}
+}
+
+// ValidateStricterThan checks that the expedited params are within valid ranges
+// and stricter than the params of regular proposals
+func (ep ExpeditedParams) ValidateStricterThan(dp DepositParams, vp VotingParams, tp TallyParams) error {
+	if ep.Threshold.IsNil() || !ep.Threshold.IsPositive() || ep.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold should be positive and less or equal to one, is %s", ep.Threshold)
+	}
+	if ep.Threshold.LT(tp.Threshold) {
+		return fmt.Errorf("expedited vote threshold should not be lower than the vote threshold %s, is %s",
+			tp.Threshold, ep.Threshold)
+	}
+	if ep.VotingPeriod <= 0 || ep.VotingPeriod >= vp.VotingPeriod {
+		return fmt.Errorf("expedited voting period should be positive and shorter than the voting period %s, is %s",
+			vp.VotingPeriod, ep.VotingPeriod)
+	}
+	if !ep.MinDeposit.IsValid() {
+		return fmt.Errorf("expedited deposit amount must be a valid sdk.Coins amount, is %s", ep.MinDeposit)
+	}
+	if !ep.MinDeposit.IsAllGTE(dp.MinDeposit) {
+		return fmt.Errorf("expedited deposit amount should not be lower than the deposit amount %s, is %s",
+			dp.MinDeposit, ep.MinDeposit)
+	}
+	return nil
+}
+
+func (ep ExpeditedParams) String() string {
+	return fmt.Sprintf(`Expedited Params:
+  Min Deposit:        %s
+  Voting Period:      %s
+  Threshold:          %s`, ep.MinDeposit, ep.VotingPeriod, ep.Threshold)
+}
+
+// Minimum deposit for the proposals of a type
+type ProposalTypeMinDeposit struct {
+	ProposalType string    `json:"proposal_type" yaml:"proposal_type"`
+	MinDeposit   sdk.Coins `json:"min_deposit" yaml:"min_deposit"`
+}
+
+// NewProposalTypeMinDeposit creates a new ProposalTypeMinDeposit object
+func NewProposalTypeMinDeposit(proposalType string, minDeposit sdk.Coins) ProposalTypeMinDeposit {
+	return ProposalTypeMinDeposit{
+		ProposalType: proposalType,
+		MinDeposit:   minDeposit,
+	}
+}
+
+// Param around the policy of deposits in governance
+type DepositPolicyParams struct {
+	MinDeposits            []ProposalTypeMinDeposit `json:"min_deposits" yaml:"min_deposits"`                           //  Minimum deposits of the proposal types which do not use the default one.
+	BurnOnQuorumFailure    bool                     `json:"burn_on_quorum_failure" yaml:"burn_on_quorum_failure"`       //  Whether deposits are burned when a proposal does not reach the quorum.
+	BurnOnReject           bool                     `json:"burn_on_reject" yaml:"burn_on_reject"`                       //  Whether deposits are burned when a proposal is rejected without a veto.
+	MinInitialDepositRatio sdk.Dec                  `json:"min_initial_deposit_ratio" yaml:"min_initial_deposit_ratio"` //  Minimum ratio of the min deposit to be deposited when submitting a proposal.
+}
+
+// NewDepositPolicyParams creates a new DepositPolicyParams object
+func NewDepositPolicyParams(minDeposits []ProposalTypeMinDeposit, burnOnQuorumFailure, burnOnReject bool,
+	minInitialDepositRatio sdk.Dec) DepositPolicyParams {
+	return DepositPolicyParams{
+		MinDeposits:            minDeposits,
+		BurnOnQuorumFailure:    burnOnQuorumFailure,
+		BurnOnReject:           burnOnReject,
+		MinInitialDepositRatio: minInitialDepositRatio,
+	}
+}
+
+// DefaultDepositPolicyParams returns the deposit policy params used until they
+// are set, which burn deposits when a proposal does not reach the quorum only
+func DefaultDepositPolicyParams() DepositPolicyParams {
+	return DepositPolicyParams{
+		MinDeposits:            []ProposalTypeMinDeposit{},
+		BurnOnQuorumFailure:    true,
+		BurnOnReject:           false,
+		MinInitialDepositRatio: sdk.ZeroDec(),
+	}
+}
+
+// MinDepositOf returns the minimum deposit of a proposal type, if it does not
+// use the default one.
+func (dp DepositPolicyParams) MinDepositOf(proposalType string) (sdk.Coins, bool) {
+	for _, minDeposit := range dp.MinDeposits {
+		if minDeposit.ProposalType == proposalType {
+			return minDeposit.MinDeposit, true
+		}
+	}
+	return nil, false
+}
+
+// Validate checks that the deposit policy params are within valid ranges
+func (dp DepositPolicyParams) Validate() error {
+	seen := make(map[string]bool)
+	for _, minDeposit := range dp.MinDeposits {
+		if !IsValidProposalType(minDeposit.ProposalType) {
+			return fmt.Errorf("invalid proposal type %s", minDeposit.ProposalType)
+		}
+		if seen[minDeposit.ProposalType] {
+			return fmt.Errorf("duplicate min deposit of proposal type %s", minDeposit.ProposalType)
+		}
+		seen[minDeposit.ProposalType] = true
+
+		if !minDeposit.MinDeposit.IsValid() {
+			return fmt.Errorf("min deposit of proposal type %s must be a valid sdk.Coins amount, is %s",
+				minDeposit.ProposalType, minDeposit.MinDeposit)
+		}
+	}
+
+	ratio := dp.MinInitialDepositRatio
+	if ratio.IsNil() || ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("min initial deposit ratio should be between zero and one, is %s", ratio)
+	}
+	return nil
+}
+
+func (dp DepositPolicyParams) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Deposit Policy Params:
+  Burn On Quorum Failure:    %t
+  Burn On Reject:            %t
+  Min Initial Deposit Ratio: %s
+  Min Deposits:`, dp.BurnOnQuorumFailure, dp.BurnOnReject, dp.MinInitialDepositRatio))
+	for _, minDeposit := range dp.MinDeposits {
+		b.WriteString(fmt.Sprintf("\n    %s: %s", minDeposit.ProposalType, minDeposit.MinDeposit))
+	}
+	return b.String()
+}
+
 // Params returns all of the governance params
 type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_parmas"`
+	VotingParams    VotingParams    `json:"voting_params" yaml:"voting_params"`
+	TallyParams     TallyParams     `json:"tally_params" yaml:"tally_params"`
+	DepositParams   DepositParams   `json:"deposit_params" yaml:"deposit_parmas"`
+	ExpeditedParams ExpeditedParams `json:"expedited_params" yaml:"expedited_params"`
+
+	DepositPolicyParams DepositPolicyParams `json:"deposit_policy_params" yaml:"deposit_policy_params"`
 }
 
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+		gp.TallyParams.String() + "\n" + gp.DepositParams.String() + "\n" +
+		gp.ExpeditedParams.String() + "\n" + gp.DepositPolicyParams.String()
 }
 
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams, ep ExpeditedParams, dpp DepositPolicyParams) Params {
 	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+		VotingParams:        vp,
+		DepositParams:       dp,
+		TallyParams:         tp,
+		ExpeditedParams:     ep,
+		DepositPolicyParams: dpp,
 	}
 }
@@ -24,6 +24,10 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time" yaml:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time" yaml:"voting_end_time"`     // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	Expedited bool `json:"expedited,omitempty" yaml:"expedited,omitempty"` // Whether the proposal has the expedited voting period and threshold
+
+	ExecResult *ExecResult `json:"exec_result,omitempty" yaml:"exec_result,omitempty"` // Result of executing the msgs of a passed ExecMsgsProposal
 }
 
 func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) Proposal {
@@ -49,10 +53,11 @@ func (p Proposal) String() string {
   Total Deposit:      %s
   Voting Start Time:  %s
   Voting End Time:    %s
+  Expedited:          %t
   Description:        %s`,
 		p.ProposalID, p.GetTitle(), p.ProposalType(),
 		p.Status, p.SubmitTime, p.DepositEndTime,
-		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
+		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.Expedited, p.GetDescription(),
 	)
 }
 
@@ -251,6 +256,7 @@ func (tr TallyResult) String() string {
 const (
 	ProposalTypeText            string = "Text"
 	ProposalTypeSoftwareUpgrade string = "SoftwareUpgrade"
+	ProposalTypeExecMsgs        string = "ExecMsgs"
 )
 
 // Text Proposal
@@ -311,9 +317,89 @@ func (sup SoftwareUpgradeProposal) String() string {
 `, sup.Title, sup.Description)
 }
 
+// ExecMsgsProposal is a proposal to execute msgs once it passes. The governance
+// module account must be the only signer of every msg.
+type ExecMsgsProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg `json:"msgs" yaml:"msgs"`
+}
+
+func NewExecMsgsProposal(title, description string, msgs []sdk.Msg) Content {
+	return ExecMsgsProposal{title, description, msgs}
+}
+
+// Implements Proposal Interface
+var _ Content = ExecMsgsProposal{}
+
+// nolint
+func (ep ExecMsgsProposal) GetTitle() string       { return ep.Title }
+func (ep ExecMsgsProposal) GetDescription() string { return ep.Description }
+func (ep ExecMsgsProposal) ProposalRoute() string  { return RouterKey }
+func (ep ExecMsgsProposal) ProposalType() string   { return ProposalTypeExecMsgs }
+
+func (ep ExecMsgsProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, ep); err != nil {
+		return err
+	}
+	if len(ep.Msgs) == 0 {
+		return ErrInvalidProposalContent(DefaultCodespace, "no msgs to execute")
+	}
+	for i, msg := range ep.Msgs {
+		if msg == nil {
+			return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("msg %d is empty", i))
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		if len(msg.GetSigners()) != 1 {
+			return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("msg %d must have exactly one signer", i))
+		}
+	}
+	return nil
+}
+
+func (ep ExecMsgsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Exec Msgs Proposal:
+  Title:       %s
+  Description: %s
+  Msgs:
+`, ep.Title, ep.Description))
+	for _, msg := range ep.Msgs {
+		b.WriteString(fmt.Sprintf("    %s/%s\n", msg.Route(), msg.Type()))
+	}
+	return b.String()
+}
+
+// ExecResult is the result of executing the msgs of a passed ExecMsgsProposal.
+type ExecResult struct {
+	Code      sdk.CodeType      `json:"code" yaml:"code"`
+	Codespace sdk.CodespaceType `json:"codespace" yaml:"codespace"`
+	Data      []byte            `json:"data" yaml:"data"`
+	Log       string            `json:"log" yaml:"log"`
+	Events    sdk.StringEvents  `json:"events" yaml:"events"`
+}
+
+func NewExecResult(res sdk.Result) *ExecResult {
+	return &ExecResult{
+		Code:      res.Code,
+		Codespace: res.Codespace,
+		Data:      res.Data,
+		Log:       res.Log,
+		Events:    sdk.StringifyEvents(res.Events.ToABCIEvents()),
+	}
+}
+
+// IsOK returns true if all msgs were executed successfully.
+func (er ExecResult) IsOK() bool {
+	return er.Code.IsOK()
+}
+
 var validProposalTypes = map[string]struct{}{
 	ProposalTypeText:            {},
 	ProposalTypeSoftwareUpgrade: {},
+	ProposalTypeExecMsgs:        {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -326,6 +412,22 @@ func RegisterProposalType(ty string) {
 	validProposalTypes[ty] = struct{}{}
 }
 
+// proposalTypeUpgrades maps the proposal types to the upgrades enabling them.
+var proposalTypeUpgrades = map[string]string{}
+
+// RegisterProposalTypeUpgrade registers the upgrade enabling a proposal type,
+// before which proposals of the type cannot be submitted.
+func RegisterProposalTypeUpgrade(ty, upgradeName string) {
+	proposalTypeUpgrades[ty] = upgradeName
+}
+
+// IsProposalTypeEnabled returns false if the upgrade enabling a proposal type
+// has not been applied yet.
+func IsProposalTypeEnabled(ty string) bool {
+	upgradeName, ok := proposalTypeUpgrades[ty]
+	return !ok || sdk.GlobalUpgradeMgr.IsUpgradeApplied(upgradeName)
+}
+
 // ContentFromProposalType returns a Content object based on the proposal type.
 func ContentFromProposalType(title, desc, ty string) Content {
 	switch ty {
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +24,47 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestExecMsgsProposalValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("gov"))
+	tests := []struct {
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{[]sdk.Msg{NewMsgVote(addr, 1, OptionYes)}, true},
+		{[]sdk.Msg{NewMsgVote(addr, 1, OptionYes), NewMsgDeposit(addr, 1, coinsPos)}, true},
+		{nil, false},
+		{[]sdk.Msg{nil}, false},
+		{[]sdk.Msg{NewMsgVote(sdk.AccAddress{}, 1, OptionYes)}, false},
+	}
+
+	for i, tc := range tests {
+		err := NewExecMsgsProposal("Test", "description", tc.msgs).ValidateBasic()
+		if tc.expectPass {
+			require.NoError(t, err, "test: %v", i)
+		} else {
+			require.Error(t, err, "test: %v", i)
+		}
+	}
+}
+
+func TestIsProposalTypeEnabled(t *testing.T) {
+	const upgradeName = "TestProposalTypeUpgrade"
+	RegisterProposalTypeUpgrade(ProposalTypeSoftwareUpgrade, upgradeName)
+	defer func() {
+		delete(proposalTypeUpgrades, ProposalTypeSoftwareUpgrade)
+		delete(sdk.GlobalUpgradeMgr.Config.UpgradeHeight, upgradeName)
+		sdk.GlobalUpgradeMgr.SetBlockHeight(0)
+	}()
+
+	// proposal types without an upgrade are always enabled
+	require.True(t, IsProposalTypeEnabled(ProposalTypeText))
+	require.False(t, IsProposalTypeEnabled(ProposalTypeSoftwareUpgrade))
+
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(upgradeName, 2)
+	sdk.GlobalUpgradeMgr.SetBlockHeight(1)
+	require.False(t, IsProposalTypeEnabled(ProposalTypeSoftwareUpgrade))
+
+	sdk.GlobalUpgradeMgr.SetBlockHeight(2)
+	require.True(t, IsProposalTypeEnabled(ProposalTypeSoftwareUpgrade))
+}
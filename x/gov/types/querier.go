@@ -15,9 +15,11 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
-	ParamDeposit  = "deposit"
-	ParamVoting   = "voting"
-	ParamTallying = "tallying"
+	ParamDeposit       = "deposit"
+	ParamVoting        = "voting"
+	ParamTallying      = "tallying"
+	ParamExpedited     = "expedited"
+	ParamDepositPolicy = "deposit_policy"
 )
 
 // Params for queries:
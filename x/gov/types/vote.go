@@ -3,23 +3,43 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/barkisnet/barkis/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`             //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`                         //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`                       //  option from OptionSet chosen by the voter
+	Options    WeightedVoteOptions `json:"options,omitempty" yaml:"options,omitempty"` //  weighted options of a weighted vote, Option is empty then
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{proposalID, voter, option, nil}
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power
+// across several options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{proposalID, voter, OptionEmpty, options}
+}
+
+// WeightedOptions returns the options of the vote with their weights, a
+// single option vote having a weight of one
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) > 0 {
+		return v.Options
+	}
+	return WeightedVoteOptions{NewWeightedVoteOption(v.Option, sdk.OneDec())}
 }
 
 func (v Vote) String() string {
+	if len(v.Options) > 0 {
+		return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
+	}
 	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
 }
 
@@ -32,6 +52,10 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
+		if len(vot.Options) > 0 {
+			out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
+			continue
+		}
 		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
 	}
 	return out
@@ -41,7 +65,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.Options.Equals(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -49,6 +74,102 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// WeightedVoteOption is a vote option with the share of the voting power
+// given to it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption
+type WeightedVoteOptions []WeightedVoteOption
+
+func (os WeightedVoteOptions) String() string {
+	strs := make([]string, len(os))
+	for i, o := range os {
+		strs[i] = o.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// Equals returns whether two collections of weighted options are equal.
+func (os WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(os) != len(comp) {
+		return false
+	}
+	for i := range os {
+		if os[i].Option != comp[i].Option || !os[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidWeightedVoteOptions returns an error unless the options are valid,
+// distinct, and their weights are positive and sum up to one.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return fmt.Errorf("no vote option")
+	}
+
+	seen := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, o := range options {
+		if !ValidVoteOption(o.Option) {
+			return fmt.Errorf("'%v' is not a valid voting option", o.Option.String())
+		}
+		if seen[o.Option] {
+			return fmt.Errorf("duplicated vote option %s", o.Option)
+		}
+		seen[o.Option] = true
+
+		if o.Weight.IsNil() || !o.Weight.IsPositive() || o.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("invalid weight %s of vote option %s", o.Weight, o.Option)
+		}
+		totalWeight = totalWeight.Add(o.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("weights sum up to %s instead of 1", totalWeight)
+	}
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns weighted vote options from a comma
+// separated list of option=weight pairs, eg. "Yes=0.6,No=0.4". It returns an
+// error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(strings.TrimSpace(str), ",") {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option, expected option=weight", pair)
+		}
+
+		option, err := VoteOptionFromString(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid weight: %s", fields[1], err)
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/x/gov/types"
+	stakingexported "github.com/barkisnet/barkis/x/staking/exported"
 )
 
 // AddVote Adds a vote on a specific proposal
@@ -35,6 +36,37 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	return nil
 }
 
+// AddWeightedVote Adds a vote splitting the voting power of the voter across
+// several options on a specific proposal
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress,
+	options WeightedVoteOptions) sdk.Error {
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+	if proposal.Status != StatusVotingPeriod {
+		return ErrInactiveProposal(keeper.codespace, proposalID)
+	}
+
+	if err := types.ValidWeightedVoteOptions(options); err != nil {
+		return types.ErrInvalidWeightedVote(keeper.codespace, err.Error())
+	}
+
+	vote := types.NewWeightedVote(proposalID, voterAddr, options)
+	keeper.setVote(ctx, proposalID, voterAddr, vote)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVote,
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
 // GetAllVotes returns all the votes from the store
 func (keeper Keeper) GetAllVotes(ctx sdk.Context) (votes Votes) {
 	keeper.IterateAllVotes(ctx, func(vote Vote) bool {
@@ -65,6 +97,21 @@ func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	return vote, true
 }
 
+// GetValidatorParticipation splits the bonded validators into the ones which
+// voted on a specific proposal and the ones which did not
+func (keeper Keeper) GetValidatorParticipation(ctx sdk.Context, proposalID uint64) (voters, nonVoters []sdk.ValAddress) {
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingexported.ValidatorI) (stop bool) {
+		valAddr := validator.GetOperator()
+		if _, found := keeper.GetVote(ctx, proposalID, sdk.AccAddress(valAddr)); found {
+			voters = append(voters, valAddr)
+		} else {
+			nonVoters = append(nonVoters, valAddr)
+		}
+		return false
+	})
+	return
+}
+
 func (keeper Keeper) setVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, vote Vote) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(vote)
@@ -81,3 +128,10 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// deleteVotes deletes all the votes on a specific proposal
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	for _, vote := range keeper.GetVotes(ctx, proposalID) {
+		keeper.deleteVote(ctx, proposalID, vote.Voter)
+	}
+}
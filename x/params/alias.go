@@ -51,6 +51,7 @@ type (
 	Subspace                = subspace.Subspace
 	ReadOnlySubspace        = subspace.ReadOnlySubspace
 	KeyTable                = subspace.KeyTable
+	ValueValidatorFn        = subspace.ValueValidatorFn
 	ParameterChangeProposal = types.ParameterChangeProposal
 	ParamChange             = types.ParamChange
 )
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/barkisnet/barkis/client/context"
 	"github.com/barkisnet/barkis/codec"
@@ -13,6 +14,7 @@ import (
 	"github.com/barkisnet/barkis/x/auth"
 	"github.com/barkisnet/barkis/x/auth/client/utils"
 	"github.com/barkisnet/barkis/x/gov"
+	govcli "github.com/barkisnet/barkis/x/gov/client/cli"
 	paramscutils "github.com/barkisnet/barkis/x/params/client/utils"
 	"github.com/barkisnet/barkis/x/params/types"
 )
@@ -76,6 +78,7 @@ Where proposal.json contains:
 			content := types.NewParameterChangeProposal(proposal.Title, proposal.Description, proposal.Changes.ToParamChanges())
 
 			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg.Expedited = viper.GetBool(govcli.FlagExpedited)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
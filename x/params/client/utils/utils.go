@@ -31,6 +31,7 @@ type (
 		Description string           `json:"description" yaml:"description"`
 		Changes     ParamChangesJSON `json:"changes" yaml:"changes"`
 		Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+		Expedited   bool             `json:"expedited" yaml:"expedited"`
 	}
 
 	// ParamChangeProposalReq defines a parameter change proposal request body.
@@ -42,6 +43,7 @@ type (
 		Changes     ParamChangesJSON `json:"changes" yaml:"changes"`
 		Proposer    sdk.AccAddress   `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+		Expedited   bool             `json:"expedited" yaml:"expedited"`
 	}
 )
 
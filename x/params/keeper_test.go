@@ -1,6 +1,7 @@
 package params
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -210,3 +211,27 @@ func TestJSONUpdate(t *testing.T) {
 	space.Get(ctx, key, &param)
 	require.Equal(t, paramJSON{40964096, "goodbyeworld"}, param)
 }
+
+func TestValidatedUpdate(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	key := []byte("key")
+	table := NewKeyTable(key, int64(0)).RegisterValidator(key, func(_ sdk.Context, i interface{}) error {
+		if i.(int64) < 0 {
+			return errors.New("negative value")
+		}
+		return nil
+	})
+	space := keeper.Subspace("test").WithKeyTable(table)
+
+	var param int64
+
+	require.NoError(t, space.Update(ctx, key, []byte(`"10"`)))
+	space.Get(ctx, key, &param)
+	require.Equal(t, int64(10), param)
+
+	// an invalid value is rejected and leaves the parameter unchanged
+	require.Error(t, space.Update(ctx, key, []byte(`"-10"`)))
+	space.Get(ctx, key, &param)
+	require.Equal(t, int64(10), param)
+}
@@ -213,6 +213,12 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if attr.validate != nil {
+		if err := attr.validate(ctx, reflect.ValueOf(dest).Elem().Interface()); err != nil {
+			return err
+		}
+	}
+
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(key, []byte{})
@@ -257,6 +263,12 @@ func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, p
 		return err
 	}
 
+	if attr.validate != nil {
+		if err := attr.validate(ctx, reflect.ValueOf(dest).Elem().Interface()); err != nil {
+			return err
+		}
+	}
+
 	s.SetWithSubkey(ctx, key, subkey, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(concatkey, []byte{})
@@ -2,10 +2,17 @@ package subspace
 
 import (
 	"reflect"
+
+	sdk "github.com/barkisnet/barkis/types"
 )
 
+// ValueValidatorFn validates a parameter value set through Update. The context
+// allows checking the value against other parameters.
+type ValueValidatorFn func(ctx sdk.Context, value interface{}) error
+
 type attribute struct {
-	ty reflect.Type
+	ty       reflect.Type
+	validate ValueValidatorFn
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -68,6 +75,21 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	return t
 }
 
+// Register the validator of the values of a registered key, run when the
+// parameter is updated by a parameter change
+func (t KeyTable) RegisterValidator(key []byte, fn ValueValidatorFn) KeyTable {
+	keystr := string(key)
+	attr, ok := t.m[keystr]
+	if !ok {
+		panic("parameter not registered")
+	}
+
+	attr.validate = fn
+	t.m[keystr] = attr
+
+	return t
+}
+
 // Register multiple pairs from ParamSet
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
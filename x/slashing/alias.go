@@ -9,24 +9,33 @@ import (
 )
 
 const (
-	DefaultCodespace            = types.DefaultCodespace
-	CodeInvalidValidator        = types.CodeInvalidValidator
-	CodeValidatorJailed         = types.CodeValidatorJailed
-	CodeValidatorNotJailed      = types.CodeValidatorNotJailed
-	CodeMissingSelfDelegation   = types.CodeMissingSelfDelegation
-	CodeSelfDelegationTooLow    = types.CodeSelfDelegationTooLow
-	CodeMissingSigningInfo      = types.CodeMissingSigningInfo
-	ModuleName                  = types.ModuleName
-	StoreKey                    = types.StoreKey
-	RouterKey                   = types.RouterKey
-	QuerierRoute                = types.QuerierRoute
-	QueryParameters             = types.QueryParameters
-	QuerySigningInfo            = types.QuerySigningInfo
-	QuerySigningInfos           = types.QuerySigningInfos
-	DefaultParamspace           = types.DefaultParamspace
-	DefaultMaxEvidenceAge       = types.DefaultMaxEvidenceAge
-	DefaultSignedBlocksWindow   = types.DefaultSignedBlocksWindow
-	DefaultDowntimeJailDuration = types.DefaultDowntimeJailDuration
+	DefaultCodespace              = types.DefaultCodespace
+	CodeInvalidValidator          = types.CodeInvalidValidator
+	CodeValidatorJailed           = types.CodeValidatorJailed
+	CodeValidatorNotJailed        = types.CodeValidatorNotJailed
+	CodeMissingSelfDelegation     = types.CodeMissingSelfDelegation
+	CodeSelfDelegationTooLow      = types.CodeSelfDelegationTooLow
+	CodeMissingSigningInfo        = types.CodeMissingSigningInfo
+	CodeValidatorTombstoned       = types.CodeValidatorTombstoned
+	ModuleName                    = types.ModuleName
+	StoreKey                      = types.StoreKey
+	RouterKey                     = types.RouterKey
+	QuerierRoute                  = types.QuerierRoute
+	QueryParameters               = types.QueryParameters
+	QuerySigningInfo              = types.QuerySigningInfo
+	QuerySigningInfos             = types.QuerySigningInfos
+	QueryGovParticipation         = types.QueryGovParticipation
+	QueryGovParticipationPolicy   = types.QueryGovParticipationPolicy
+	QueryDowntimeEscalationPolicy = types.QueryDowntimeEscalationPolicy
+	DefaultParamspace             = types.DefaultParamspace
+	DefaultMaxEvidenceAge         = types.DefaultMaxEvidenceAge
+	DefaultSignedBlocksWindow     = types.DefaultSignedBlocksWindow
+	DefaultDowntimeJailDuration   = types.DefaultDowntimeJailDuration
+	DefaultMaxMissedGovVotes      = types.DefaultMaxMissedGovVotes
+	DefaultMaxDowntimeJail        = types.DefaultMaxDowntimeJail
+	DefaultDowntimeDecayPeriod    = types.DefaultDowntimeDecayPeriod
+	MaxJailDurationFactor         = types.MaxJailDurationFactor
+	GovVoteHistoryLength          = types.GovVoteHistoryLength
 )
 
 var (
@@ -39,6 +48,7 @@ var (
 	ErrMissingSelfDelegation                 = types.ErrMissingSelfDelegation
 	ErrSelfDelegationTooLowToUnjail          = types.ErrSelfDelegationTooLowToUnjail
 	ErrNoSigningInfoFound                    = types.ErrNoSigningInfoFound
+	ErrValidatorTombstoned                   = types.ErrValidatorTombstoned
 	NewGenesisState                          = types.NewGenesisState
 	DefaultGenesisState                      = types.DefaultGenesisState
 	ValidateGenesis                          = types.ValidateGenesis
@@ -47,12 +57,22 @@ var (
 	GetValidatorMissedBlockBitArrayPrefixKey = types.GetValidatorMissedBlockBitArrayPrefixKey
 	GetValidatorMissedBlockBitArrayKey       = types.GetValidatorMissedBlockBitArrayKey
 	GetAddrPubkeyRelationKey                 = types.GetAddrPubkeyRelationKey
+	GetValidatorMissedGovVotesKey            = types.GetValidatorMissedGovVotesKey
+	GetValidatorMissedGovVotesAddress        = types.GetValidatorMissedGovVotesAddress
+	GetValidatorGovVoteHistoryPrefixKey      = types.GetValidatorGovVoteHistoryPrefixKey
+	GetValidatorGovVoteHistoryKey            = types.GetValidatorGovVoteHistoryKey
+	NewValidatorGovParticipation             = types.NewValidatorGovParticipation
 	NewMsgUnjail                             = types.NewMsgUnjail
 	ParamKeyTable                            = types.ParamKeyTable
 	NewParams                                = types.NewParams
 	DefaultParams                            = types.DefaultParams
+	NewGovParticipationParams                = types.NewGovParticipationParams
+	DefaultGovParticipationParams            = types.DefaultGovParticipationParams
+	NewDowntimeEscalationParams              = types.NewDowntimeEscalationParams
+	DefaultDowntimeEscalationParams          = types.DefaultDowntimeEscalationParams
 	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
+	NewQueryGovParticipationParams           = types.NewQueryGovParticipationParams
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
 
 	// variable aliases
@@ -60,6 +80,8 @@ var (
 	ValidatorSigningInfoKey         = types.ValidatorSigningInfoKey
 	ValidatorMissedBlockBitArrayKey = types.ValidatorMissedBlockBitArrayKey
 	AddrPubkeyRelationKey           = types.AddrPubkeyRelationKey
+	ValidatorMissedGovVotesKey      = types.ValidatorMissedGovVotesKey
+	ValidatorGovVoteHistoryKey      = types.ValidatorGovVoteHistoryKey
 	DoubleSignJailEndTime           = types.DoubleSignJailEndTime
 	DefaultMinSignedPerWindow       = types.DefaultMinSignedPerWindow
 	DefaultSlashFractionDoubleSign  = types.DefaultSlashFractionDoubleSign
@@ -70,6 +92,8 @@ var (
 	KeyDowntimeJailDuration         = types.KeyDowntimeJailDuration
 	KeySlashFractionDoubleSign      = types.KeySlashFractionDoubleSign
 	KeySlashFractionDowntime        = types.KeySlashFractionDowntime
+	KeyGovParticipationParams       = types.KeyGovParticipationParams
+	KeyDowntimeEscalation           = types.KeyDowntimeEscalation
 )
 
 type (
@@ -81,4 +105,10 @@ type (
 	QuerySigningInfoParams  = types.QuerySigningInfoParams
 	QuerySigningInfosParams = types.QuerySigningInfosParams
 	ValidatorSigningInfo    = types.ValidatorSigningInfo
+
+	GovParticipationParams      = types.GovParticipationParams
+	DowntimeEscalationParams    = types.DowntimeEscalationParams
+	GovVote                     = types.GovVote
+	ValidatorGovParticipation   = types.ValidatorGovParticipation
+	QueryGovParticipationParams = types.QueryGovParticipationParams
 )
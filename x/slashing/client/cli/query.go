@@ -29,6 +29,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetCmdQuerySigningInfo(queryRoute, cdc),
 			GetCmdQueryParams(cdc),
+			GetCmdQueryGovParticipation(queryRoute, cdc),
+			GetCmdQueryGovParticipationParams(queryRoute, cdc),
+			GetCmdQueryDowntimeEscalationParams(queryRoute, cdc),
 		)...,
 	)
 
@@ -98,3 +101,94 @@ $ <appcli> query slashing params
 		},
 	}
 }
+
+// GetCmdQueryGovParticipation implements the command to query the governance
+// participation of a validator.
+func GetCmdQueryGovParticipation(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "gov-participation [validator-addr]",
+		Short: "Query a validator's governance participation history",
+		Long: strings.TrimSpace(`Use a validator's operator address to find its missed governance votes counter and the proposals it voted or did not vote on:
+
+$ <appcli> query slashing gov-participation cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryGovParticipationParams(valAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGovParticipation)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var participation types.ValidatorGovParticipation
+			cdc.MustUnmarshalJSON(res, &participation)
+			return cliCtx.PrintOutput(participation)
+		},
+	}
+}
+
+// GetCmdQueryGovParticipationParams implements a command to fetch the policy
+// applied to the validators missing governance votes.
+func GetCmdQueryGovParticipationParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "gov-participation-params",
+		Short: "Query the current policy for validators missing governance votes",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the policy applied to the bonded validators which do not vote on governance proposals:
+
+$ <appcli> query slashing gov-participation-params
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGovParticipationPolicy)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.GovParticipationParams
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryDowntimeEscalationParams implements a command to fetch the
+// graduated downtime policy.
+func GetCmdQueryDowntimeEscalationParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "downtime-escalation-params",
+		Short: "Query the current graduated downtime policy",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the policy escalating the jail duration and the slash fraction of repeat downtime offenders:
+
+$ <appcli> query slashing downtime-escalation-params
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDowntimeEscalationPolicy)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.DowntimeEscalationParams
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
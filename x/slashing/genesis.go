@@ -34,7 +34,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, stakingKeeper types.StakingKeep
 		}
 	}
 
+	for addr, missed := range data.MissedGovVotes {
+		address, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		keeper.setValidatorMissedGovVotes(ctx, address, missed)
+	}
+
+	for addr, votes := range data.GovVotes {
+		address, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		for _, vote := range votes {
+			keeper.setValidatorGovVote(ctx, address, vote.ProposalID, vote.Voted)
+		}
+		keeper.pruneValidatorGovVoteHistory(ctx, address)
+	}
+
 	keeper.paramspace.SetParamSet(ctx, &data.Params)
+	if !data.GovParticipationParams.SlashFraction.IsNil() {
+		keeper.SetGovParticipationParams(ctx, data.GovParticipationParams)
+	}
+	if !data.DowntimeEscalationParams.SlashFractionStep.IsNil() {
+		keeper.SetDowntimeEscalationParams(ctx, data.DowntimeEscalationParams)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -60,9 +85,28 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 		return false
 	})
 
+	missedGovVotes := make(map[string]int64)
+	keeper.IterateValidatorMissedGovVotes(ctx, func(address sdk.ValAddress, missed int64) (stop bool) {
+		missedGovVotes[address.String()] = missed
+		return false
+	})
+
+	govVotes := make(map[string][]types.GovVote)
+	keeper.IterateGovVoteHistories(ctx, func(address sdk.ValAddress, proposalID uint64, voted bool) (stop bool) {
+		bechAddr := address.String()
+		govVotes[bechAddr] = append(govVotes[bechAddr], types.GovVote{ProposalID: proposalID, Voted: voted})
+		return false
+	})
+
 	return types.GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+
+		GovParticipationParams: keeper.GovParticipationParams(ctx),
+		MissedGovVotes:         missedGovVotes,
+		GovVotes:               govVotes,
+
+		DowntimeEscalationParams: keeper.DowntimeEscalationParams(ctx),
 	}
 }
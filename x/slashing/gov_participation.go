@@ -0,0 +1,217 @@
+package slashing
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/slashing/types"
+)
+
+// Stored by *operator* address (not consensus address)
+func (k Keeper) GetValidatorMissedGovVotes(ctx sdk.Context, address sdk.ValAddress) (missed int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorMissedGovVotesKey(address))
+	if bz == nil {
+		// lazy: treat empty key as no missed vote
+		return 0
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &missed)
+	return
+}
+
+// Stored by *operator* address (not consensus address)
+func (k Keeper) setValidatorMissedGovVotes(ctx sdk.Context, address sdk.ValAddress, missed int64) {
+	store := ctx.KVStore(k.storeKey)
+	if missed == 0 {
+		store.Delete(types.GetValidatorMissedGovVotesKey(address))
+		return
+	}
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(missed)
+	store.Set(types.GetValidatorMissedGovVotesKey(address), bz)
+}
+
+// Stored by *operator* address (not consensus address)
+func (k Keeper) IterateValidatorMissedGovVotes(ctx sdk.Context,
+	handler func(address sdk.ValAddress, missed int64) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedGovVotesKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address := types.GetValidatorMissedGovVotesAddress(iter.Key())
+		var missed int64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &missed)
+		if handler(address, missed) {
+			break
+		}
+	}
+}
+
+// Stored by *operator* address (not consensus address)
+func (k Keeper) setValidatorGovVote(ctx sdk.Context, address sdk.ValAddress, proposalID uint64, voted bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(voted)
+	store.Set(types.GetValidatorGovVoteHistoryKey(address, proposalID), bz)
+}
+
+// Stored by *operator* address (not consensus address)
+func (k Keeper) IterateValidatorGovVoteHistory(ctx sdk.Context, address sdk.ValAddress,
+	handler func(proposalID uint64, voted bool) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetValidatorGovVoteHistoryPrefixKey(address)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		proposalID := binary.BigEndian.Uint64(iter.Key()[len(prefix):])
+		var voted bool
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &voted)
+		if handler(proposalID, voted) {
+			break
+		}
+	}
+}
+
+// prune the governance votes history of a validator, keeping only the
+// GovVoteHistoryLength most recent votes
+func (k Keeper) pruneValidatorGovVoteHistory(ctx sdk.Context, address sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.GetValidatorGovVoteHistoryPrefixKey(address))
+	defer iter.Close()
+	var stale [][]byte
+	for kept := 0; iter.Valid(); iter.Next() {
+		if kept < types.GovVoteHistoryLength {
+			kept++
+			continue
+		}
+		stale = append(stale, iter.Key())
+	}
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
+
+// delete the missed governance votes counter and the governance votes history
+// of a validator
+func (k Keeper) deleteValidatorGovParticipation(ctx sdk.Context, address sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorMissedGovVotesKey(address))
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorGovVoteHistoryPrefixKey(address))
+	defer iter.Close()
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// Iterate over the governance votes history of all the validators
+func (k Keeper) IterateGovVoteHistories(ctx sdk.Context,
+	handler func(address sdk.ValAddress, proposalID uint64, voted bool) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorGovVoteHistoryKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[1:]
+		address := sdk.ValAddress(key[:sdk.AddrLen])
+		proposalID := binary.BigEndian.Uint64(key[sdk.AddrLen:])
+		var voted bool
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &voted)
+		if handler(address, proposalID, voted) {
+			break
+		}
+	}
+}
+
+// GetValidatorGovParticipation returns the missed governance votes counter and
+// the governance participation history of a validator
+func (k Keeper) GetValidatorGovParticipation(ctx sdk.Context, address sdk.ValAddress) types.ValidatorGovParticipation {
+	votes := []types.GovVote{}
+	k.IterateValidatorGovVoteHistory(ctx, address, func(proposalID uint64, voted bool) (stop bool) {
+		votes = append(votes, types.GovVote{ProposalID: proposalID, Voted: voted})
+		return false
+	})
+	return types.NewValidatorGovParticipation(address, k.GetValidatorMissedGovVotes(ctx, address), votes)
+}
+
+// handle the end of the voting period of a governance proposal, recording the
+// participation of the bonded validators and punishing the ones which missed
+// too many votes
+func (k Keeper) HandleProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64, voters, nonVoters []sdk.ValAddress) {
+	for _, valAddr := range voters {
+		k.setValidatorGovVote(ctx, valAddr, proposalID, true)
+		k.pruneValidatorGovVoteHistory(ctx, valAddr)
+	}
+
+	params := k.GovParticipationParams(ctx)
+	for _, valAddr := range nonVoters {
+		k.setValidatorGovVote(ctx, valAddr, proposalID, false)
+		k.pruneValidatorGovVoteHistory(ctx, valAddr)
+
+		missed := k.GetValidatorMissedGovVotes(ctx, valAddr) + 1
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeGovVote,
+				sdk.NewAttribute(types.AttributeKeyAddress, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+				sdk.NewAttribute(types.AttributeKeyMissedVotes, fmt.Sprintf("%d", missed)),
+			),
+		)
+
+		if params.Enabled && missed > params.MaxMissedVotes {
+			k.punishGovNonVoter(ctx, valAddr, missed, params)
+			// reset the counter so that the validator won't be punished again on the next missed vote
+			missed = 0
+		}
+		k.setValidatorMissedGovVotes(ctx, valAddr, missed)
+	}
+}
+
+// slash and/or jail a validator which missed too many governance votes
+func (k Keeper) punishGovNonVoter(ctx sdk.Context, valAddr sdk.ValAddress, missed int64, params types.GovParticipationParams) {
+	validator := k.sk.Validator(ctx, valAddr)
+	if validator == nil {
+		return
+	}
+
+	consAddr := validator.GetConsAddr()
+	power := validator.GetConsensusPower()
+
+	k.Logger(ctx).Info(fmt.Sprintf("Validator %s missed %d governance votes, threshold %d",
+		valAddr, missed, params.MaxMissedVotes))
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+		sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingGovVote),
+	}
+	jail := params.Jail && !validator.IsJailed()
+	if jail {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSlash, attributes...))
+
+	// the infraction happens at the current height, so there are no unbonding
+	// delegations nor redelegations to slash
+	if params.SlashFraction.IsPositive() {
+		k.sk.Slash(ctx, consAddr, ctx.BlockHeight(), power, params.SlashFraction)
+	}
+
+	if jail {
+		k.sk.Jail(ctx, consAddr)
+
+		signInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
+		if found && !signInfo.Tombstoned {
+			jailedUntil := ctx.BlockHeader().Time.Add(params.JailDuration)
+			if jailedUntil.After(signInfo.JailedUntil) {
+				signInfo.JailedUntil = jailedUntil
+			}
+			k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+	"github.com/barkisnet/barkis/x/staking"
+)
+
+func TestHandleProposalVotingPeriodEnded(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	voterAddr, nonVoterAddr := addrs[0], addrs[1]
+	for i, addr := range []sdk.ValAddress{voterAddr, nonVoterAddr} {
+		got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, pks[i], amt))
+		require.True(t, got.IsOK())
+	}
+	staking.EndBlocker(ctx, sk)
+
+	voters, nonVoters := []sdk.ValAddress{voterAddr}, []sdk.ValAddress{nonVoterAddr}
+
+	// the policy is disabled by default, missed votes are only recorded
+	for proposalID := uint64(1); proposalID <= 5; proposalID++ {
+		keeper.HandleProposalVotingPeriodEnded(ctx, proposalID, voters, nonVoters)
+	}
+	require.Equal(t, int64(5), keeper.GetValidatorMissedGovVotes(ctx, nonVoterAddr))
+	require.Equal(t, int64(0), keeper.GetValidatorMissedGovVotes(ctx, voterAddr))
+	require.False(t, sk.Validator(ctx, nonVoterAddr).IsJailed())
+
+	participation := keeper.GetValidatorGovParticipation(ctx, nonVoterAddr)
+	require.Len(t, participation.Votes, 5)
+	require.Equal(t, uint64(1), participation.Votes[0].ProposalID)
+	require.False(t, participation.Votes[0].Voted)
+	participation = keeper.GetValidatorGovParticipation(ctx, voterAddr)
+	require.Len(t, participation.Votes, 5)
+	require.True(t, participation.Votes[4].Voted)
+
+	// once enabled, passing the threshold slashes and jails the validator
+	keeper.SetGovParticipationParams(ctx, NewGovParticipationParams(true, 5, true, time.Hour, sdk.NewDecWithPrec(1, 2)))
+	oldTokens := sk.Validator(ctx, nonVoterAddr).GetTokens()
+	keeper.HandleProposalVotingPeriodEnded(ctx, 6, voters, nonVoters)
+
+	validator := sk.Validator(ctx, nonVoterAddr)
+	require.True(t, validator.IsJailed())
+	require.True(t, validator.GetTokens().LT(oldTokens))
+	require.False(t, sk.Validator(ctx, voterAddr).IsJailed())
+	require.Equal(t, int64(0), keeper.GetValidatorMissedGovVotes(ctx, nonVoterAddr))
+
+	info, found := keeper.getValidatorSigningInfo(ctx, validator.GetConsAddr())
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeader().Time.Add(time.Hour).UTC(), info.JailedUntil)
+
+	// the policy and the history are exported with the genesis state
+	genesis := ExportGenesis(ctx, keeper)
+	require.Equal(t, int64(5), genesis.GovParticipationParams.MaxMissedVotes)
+	require.Len(t, genesis.GovVotes[nonVoterAddr.String()], 6)
+	require.NoError(t, genesis.GovParticipationParams.Validate())
+}
+
+func TestGovVoteHistoryPruning(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	amt := sdk.TokensFromConsensusPower(100)
+	valAddr := addrs[0]
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(valAddr, pks[0], amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	total := uint64(GovVoteHistoryLength + 10)
+	for proposalID := uint64(1); proposalID <= total; proposalID++ {
+		keeper.HandleProposalVotingPeriodEnded(ctx, proposalID, nil, []sdk.ValAddress{valAddr})
+	}
+
+	// only the most recent votes are kept
+	participation := keeper.GetValidatorGovParticipation(ctx, valAddr)
+	require.Len(t, participation.Votes, GovVoteHistoryLength)
+	require.Equal(t, total-GovVoteHistoryLength+1, participation.Votes[0].ProposalID)
+	require.Equal(t, total, participation.Votes[GovVoteHistoryLength-1].ProposalID)
+	require.Equal(t, int64(total), keeper.GetValidatorMissedGovVotes(ctx, valAddr))
+
+	// the participation is deleted with the validator
+	keeper.AfterValidatorRemoved(ctx, sdk.ConsAddress(pks[0].Address()), valAddr)
+	participation = keeper.GetValidatorGovParticipation(ctx, valAddr)
+	require.Empty(t, participation.Votes)
+	require.Equal(t, int64(0), participation.MissedVotesCounter)
+}
+
+func TestUpdateGovParticipationParams(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+
+	p := NewGovParticipationParams(true, 5, true, time.Hour, sdk.NewDecWithPrec(1, 2))
+	bz := keeper.cdc.MustMarshalJSON(p)
+	require.NoError(t, keeper.paramspace.Update(ctx, KeyGovParticipationParams, bz))
+	require.Equal(t, int64(5), keeper.GovParticipationParams(ctx).MaxMissedVotes)
+
+	// an invalid policy is rejected
+	p.MaxMissedVotes = -1
+	bz = keeper.cdc.MustMarshalJSON(p)
+	require.Error(t, keeper.paramspace.Update(ctx, KeyGovParticipationParams, bz))
+	require.Equal(t, int64(5), keeper.GovParticipationParams(ctx).MaxMissedVotes)
+}
@@ -36,7 +36,8 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 		return ErrMissingSelfDelegation(k.codespace).Result()
 	}
 
-	if validator.TokensFromShares(selfDel.GetShares()).TruncateInt().LT(validator.GetMinSelfDelegation()) {
+	selfBond := validator.TokensFromShares(selfDel.GetShares()).TruncateInt()
+	if selfBond.LT(validator.GetMinSelfDelegation()) || selfBond.LT(k.sk.MinSelfBond(ctx)) {
 		return ErrSelfDelegationTooLowToUnjail(k.codespace).Result()
 	}
 
@@ -54,6 +55,9 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 
 	// cannot be unjailed if tombstoned
 	if info.Tombstoned {
+		if sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.TombstoneUpgrade) {
+			return ErrValidatorTombstoned(k.codespace).Result()
+		}
 		return ErrValidatorJailed(k.codespace).Result()
 	}
 
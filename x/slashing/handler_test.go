@@ -124,6 +124,42 @@ func TestJailedValidatorDelegations(t *testing.T) {
 	require.True(t, got.IsOK(), "expected jailed validator to be able to unjail, got: %v", got)
 }
 
+func TestTombstonedConsensusKeyCannotRejoin(t *testing.T) {
+	sdk.GlobalUpgradeMgr.RegisterUpgradeHeight(sdk.TombstoneUpgrade, 1)
+	sdk.GlobalUpgradeMgr.SetBlockHeight(1)
+	defer func() {
+		delete(sdk.GlobalUpgradeMgr.Config.UpgradeHeight, sdk.TombstoneUpgrade)
+		sdk.GlobalUpgradeMgr.SetBlockHeight(0)
+	}()
+
+	ctx, _, sk, _, keeper := createTestInput(t, DefaultParams())
+	slh := NewHandler(keeper)
+	amt := sdk.TokensFromConsensusPower(100)
+	addr, val := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+
+	// the consensus key has been tombstoned by a former validator
+	keeper.SetValidatorSigningInfo(ctx, consAddr,
+		NewValidatorSigningInfo(consAddr, 0, 0, DoubleSignJailEndTime, true, 0))
+	require.True(t, keeper.IsTombstoned(ctx, consAddr))
+
+	// a new validator reusing the key is jailed on creation
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK(), "%v", got)
+	staking.EndBlocker(ctx, sk)
+
+	validator := sk.Validator(ctx, addr)
+	require.True(t, validator.IsJailed())
+	require.False(t, validator.IsBonded())
+	require.Equal(t, amt, validator.GetTokens())
+
+	// and can never be unjailed
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(sk.GetParams(ctx).UnbondingTime)})
+	got = slh(ctx, NewMsgUnjail(addr))
+	require.False(t, got.IsOK())
+	require.Equal(t, CodeValidatorTombstoned, got.Code)
+}
+
 func TestInvalidMsg(t *testing.T) {
 	k := Keeper{}
 	h := NewHandler(k)
@@ -2,6 +2,7 @@
 package slashing
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -27,14 +28,24 @@ func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _
 }
 
 // When a validator is created, add the address-pubkey relation.
+// A validator reusing a tombstoned consensus key is jailed right away, and can
+// never be unjailed.
 func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	validator := k.sk.Validator(ctx, valAddr)
 	k.addPubkey(ctx, validator.GetConsPubKey())
+
+	consAddr := validator.GetConsAddr()
+	if sdk.GlobalUpgradeMgr.IsUpgradeApplied(sdk.TombstoneUpgrade) && k.IsTombstoned(ctx, consAddr) && !validator.IsJailed() {
+		k.Logger(ctx).Info(fmt.Sprintf("Validator %s created with tombstoned consensus address %s, jailed", valAddr, consAddr))
+		k.sk.Jail(ctx, consAddr)
+	}
 }
 
-// When a validator is removed, delete the address-pubkey relation.
-func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) {
+// When a validator is removed, delete the address-pubkey relation and its
+// governance participation.
+func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress, valAddr sdk.ValAddress) {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
+	k.deleteValidatorGovParticipation(ctx, valAddr)
 }
 
 //_________________________________________________________________________________________
@@ -57,8 +68,8 @@ func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, v
 }
 
 // Implements sdk.ValidatorHooks
-func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) {
-	h.k.AfterValidatorRemoved(ctx, consAddr)
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.AfterValidatorRemoved(ctx, consAddr, valAddr)
 }
 
 // Implements sdk.ValidatorHooks
@@ -74,3 +85,22 @@ func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
+
+//_________________________________________________________________________________________
+
+// GovHooks wrapper struct for slashing keeper
+type GovHooks struct {
+	k Keeper
+}
+
+var _ types.GovHooks = GovHooks{}
+
+// Return the governance wrapper struct
+func (k Keeper) GovHooks() GovHooks {
+	return GovHooks{k}
+}
+
+// Implements gov.GovHooks
+func (h GovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64, voters, nonVoters []sdk.ValAddress) {
+	h.k.HandleProposalVotingPeriodEnded(ctx, proposalID, voters, nonVoters)
+}
@@ -216,19 +216,34 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeSlash,
-					sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
-					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
-					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
-					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
-				),
-			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			attributes := []sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+				sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
+				sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+			}
+
+			// repeat offenders get escalating penalties, the previous offences
+			// being forgiven after a clean streak. The streak only counts the
+			// blocks the validator was expected to sign, which the index offset
+			// does since the last downtime offence, so the blocks spent jailed
+			// don't forgive the offences.
+			jailDuration, slashFraction := k.DowntimeJailDuration(ctx), k.SlashFractionDowntime(ctx)
+			if escalation := k.DowntimeEscalationParams(ctx); escalation.Enabled {
+				offences := escalation.Decay(signInfo.DowntimeOffences, signInfo.IndexOffset)
+				jailDuration, slashFraction = escalation.Escalate(jailDuration, slashFraction, offences)
+				signInfo.DowntimeOffences = offences + 1
+				signInfo.LastDowntimeHeight = height
+
+				attributes = append(attributes,
+					sdk.NewAttribute(types.AttributeKeyDowntimeOffences, fmt.Sprintf("%d", signInfo.DowntimeOffences)))
+			}
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSlash, attributes...))
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -272,6 +272,71 @@ func TestHandleAbsentValidator(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.GetStatus())
 }
 
+// Test that a repeat downtime offender gets escalating penalties
+func TestHandleAbsentValidatorEscalation(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	addr, val := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	escalation := NewDowntimeEscalationParams(true, 2, sdk.NewDecWithPrec(1, 2), 24*time.Hour, sdk.NewDecWithPrec(5, 2), 1000)
+	keeper.SetDowntimeEscalationParams(ctx, escalation)
+
+	window := keeper.SignedBlocksWindow(ctx)
+	maxMissed := window - keeper.MinSignedPerWindow(ctx)
+
+	// three previous offences, one of them forgiven after the blocks of the
+	// validator set
+	info, found := keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	info.DowntimeOffences = 3
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	height := int64(0)
+	for ; height < window; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+	for ; height <= window+maxMissed; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+	require.True(t, sk.Validator(ctx, addr).IsJailed())
+
+	// jailed for twice the base duration twice, slashed with twice the step
+	info, found = keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(3), info.DowntimeOffences)
+	require.Equal(t, height-1, info.LastDowntimeHeight)
+	require.Equal(t, ctx.BlockHeader().Time.Add(4*keeper.DowntimeJailDuration(ctx)).UTC(), info.JailedUntil)
+
+	slashFraction := keeper.SlashFractionDowntime(ctx).Add(sdk.NewDecWithPrec(2, 2))
+	slashAmt := amt.ToDec().Mul(slashFraction).RoundInt64()
+	require.Equal(t, amt.Int64()-slashAmt, sk.Validator(ctx, addr).GetTokens().Int64())
+
+	// the blocks spent jailed don't forgive any offence
+	staking.EndBlocker(ctx, sk)
+	height += 10 * escalation.DecayPeriod
+	ctx = ctx.WithBlockHeight(height)
+	sk.Unjail(ctx, consAddr)
+	staking.EndBlocker(ctx, sk)
+	require.Equal(t, sdk.Bonded, sk.Validator(ctx, addr).GetStatus())
+	for end := height + maxMissed + 1; height < end; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+	require.True(t, sk.Validator(ctx, addr).IsJailed())
+
+	info, found = keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(4), info.DowntimeOffences)
+	require.Equal(t, ctx.BlockHeader().Time.Add(8*keeper.DowntimeJailDuration(ctx)).UTC(), info.JailedUntil)
+}
+
 // Test a new validator entering the validator set
 // Ensure that SigningInfo.StartHeight is set correctly
 // and that they are not immediately jailed
@@ -472,3 +537,19 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+func TestUpdateDowntimeEscalationParams(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+
+	p := DefaultDowntimeEscalationParams()
+	p.JailDurationFactor = MaxJailDurationFactor
+	bz := keeper.cdc.MustMarshalJSON(p)
+	require.NoError(t, keeper.paramspace.Update(ctx, KeyDowntimeEscalation, bz))
+	require.Equal(t, MaxJailDurationFactor, keeper.DowntimeEscalationParams(ctx).JailDurationFactor)
+
+	// a factor which could overflow the escalated jail duration is rejected
+	p.JailDurationFactor = MaxJailDurationFactor + 1
+	bz = keeper.cdc.MustMarshalJSON(p)
+	require.Error(t, keeper.paramspace.Update(ctx, KeyDowntimeEscalation, bz))
+	require.Equal(t, MaxJailDurationFactor, keeper.DowntimeEscalationParams(ctx).JailDurationFactor)
+}
@@ -48,6 +48,33 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// GovParticipationParams returns the policy applied to the validators missing
+// governance votes, or the default one if it has not been set yet
+func (k Keeper) GovParticipationParams(ctx sdk.Context) types.GovParticipationParams {
+	govParticipationParams := types.DefaultGovParticipationParams()
+	k.paramspace.GetIfExists(ctx, types.KeyGovParticipationParams, &govParticipationParams)
+	return govParticipationParams
+}
+
+// SetGovParticipationParams sets the policy applied to the validators missing
+// governance votes
+func (k Keeper) SetGovParticipationParams(ctx sdk.Context, govParticipationParams types.GovParticipationParams) {
+	k.paramspace.Set(ctx, types.KeyGovParticipationParams, &govParticipationParams)
+}
+
+// DowntimeEscalationParams returns the graduated downtime policy, or the
+// default one if it has not been set yet
+func (k Keeper) DowntimeEscalationParams(ctx sdk.Context) types.DowntimeEscalationParams {
+	downtimeEscalationParams := types.DefaultDowntimeEscalationParams()
+	k.paramspace.GetIfExists(ctx, types.KeyDowntimeEscalation, &downtimeEscalationParams)
+	return downtimeEscalationParams
+}
+
+// SetDowntimeEscalationParams sets the graduated downtime policy
+func (k Keeper) SetDowntimeEscalationParams(ctx sdk.Context, downtimeEscalationParams types.DowntimeEscalationParams) {
+	k.paramspace.Set(ctx, types.KeyDowntimeEscalation, &downtimeEscalationParams)
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
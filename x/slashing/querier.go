@@ -20,6 +20,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return querySigningInfo(ctx, req, k)
 		case QuerySigningInfos:
 			return querySigningInfos(ctx, req, k)
+		case QueryGovParticipation:
+			return queryGovParticipation(ctx, req, k)
+		case QueryGovParticipationPolicy:
+			return queryGovParticipationPolicy(ctx, k)
+		case QueryDowntimeEscalationPolicy:
+			return queryDowntimeEscalationPolicy(ctx, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -87,3 +93,43 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 
 	return res, nil
 }
+
+func queryGovParticipation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryGovParticipationParams
+
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	participation := k.GetValidatorGovParticipation(ctx, params.ValAddress)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, participation)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryGovParticipationPolicy(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	params := k.GovParticipationParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryDowntimeEscalationPolicy(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	params := k.DowntimeEscalationParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
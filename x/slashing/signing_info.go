@@ -18,6 +18,13 @@ func (k Keeper) getValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	return
 }
 
+// IsTombstoned returns whether or not the validator with the given consensus
+// address has been tombstoned for double signing
+func (k Keeper) IsTombstoned(ctx sdk.Context, address sdk.ConsAddress) bool {
+	info, found := k.getValidatorSigningInfo(ctx, address)
+	return found && info.Tombstoned
+}
+
 // Stored by *validator* address (not operator address)
 func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool)) {
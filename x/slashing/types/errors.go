@@ -20,6 +20,7 @@ const (
 	CodeMissingSelfDelegation CodeType = 104
 	CodeSelfDelegationTooLow  CodeType = 105
 	CodeMissingSigningInfo    CodeType = 106
+	CodeValidatorTombstoned   CodeType = 107
 )
 
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
@@ -34,6 +35,10 @@ func ErrValidatorJailed(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorJailed, "validator still jailed, cannot yet be unjailed")
 }
 
+func ErrValidatorTombstoned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "validator has been tombstoned for double signing, cannot be unjailed")
+}
+
 func ErrValidatorNotJailed(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorNotJailed, "validator not jailed, cannot be unjailed")
 }
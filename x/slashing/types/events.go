@@ -4,6 +4,7 @@ package types
 var (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
+	EventTypeGovVote  = "gov_participation"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -11,8 +12,13 @@ var (
 	AttributeKeyReason       = "reason"
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyProposalID   = "proposal_id"
+	AttributeKeyMissedVotes  = "missed_votes"
+
+	AttributeKeyDowntimeOffences = "downtime_offences"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
+	AttributeValueMissingGovVote   = "missing_gov_vote"
 	AttributeValueCategory         = ModuleName
 )
@@ -31,6 +31,9 @@ type StakingKeeper interface {
 
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(sdk.Context) uint16
+
+	// MinSelfBond returns the minimum self-delegation required from every validator
+	MinSelfBond(sdk.Context) sdk.Int
 }
 
 // StakingHooks event hooks for staking validator object
@@ -40,3 +43,8 @@ type StakingHooks interface {
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is bonded
 }
+
+// GovHooks event hooks for governance proposals
+type GovHooks interface {
+	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64, voters, nonVoters []sdk.ValAddress) // Must be called when the voting period of a proposal ends
+}
@@ -12,6 +12,12 @@ type GenesisState struct {
 	Params       Params                          `json:"params" yaml:"params"`
 	SigningInfos map[string]ValidatorSigningInfo `json:"signing_infos" yaml:"signing_infos"`
 	MissedBlocks map[string][]MissedBlock        `json:"missed_blocks" yaml:"missed_blocks"`
+
+	GovParticipationParams GovParticipationParams `json:"gov_participation_params" yaml:"gov_participation_params"`
+	MissedGovVotes         map[string]int64       `json:"missed_gov_votes" yaml:"missed_gov_votes"`
+	GovVotes               map[string][]GovVote   `json:"gov_votes" yaml:"gov_votes"`
+
+	DowntimeEscalationParams DowntimeEscalationParams `json:"downtime_escalation_params" yaml:"downtime_escalation_params"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -38,6 +44,12 @@ func DefaultGenesisState() GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: make(map[string]ValidatorSigningInfo),
 		MissedBlocks: make(map[string][]MissedBlock),
+
+		GovParticipationParams: DefaultGovParticipationParams(),
+		MissedGovVotes:         make(map[string]int64),
+		GovVotes:               make(map[string][]GovVote),
+
+		DowntimeEscalationParams: DefaultDowntimeEscalationParams(),
 	}
 }
 
@@ -73,5 +85,17 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if !data.GovParticipationParams.SlashFraction.IsNil() {
+		if err := data.GovParticipationParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if !data.DowntimeEscalationParams.SlashFractionStep.IsNil() {
+		if err := data.DowntimeEscalationParams.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+// GovVote records whether or not a validator voted on a governance proposal
+type GovVote struct {
+	ProposalID uint64 `json:"proposal_id" yaml:"proposal_id"`
+	Voted      bool   `json:"voted" yaml:"voted"`
+}
+
+// Governance participation of a validator
+type ValidatorGovParticipation struct {
+	Address            sdk.ValAddress `json:"address" yaml:"address"`                           // validator operator address
+	MissedVotesCounter int64          `json:"missed_votes_counter" yaml:"missed_votes_counter"` // missed votes since the validator was last punished
+	Votes              []GovVote      `json:"votes" yaml:"votes"`                               // participation history, by proposal
+}
+
+// Construct a new `ValidatorGovParticipation` struct
+func NewValidatorGovParticipation(valAddr sdk.ValAddress, missedVotesCounter int64, votes []GovVote) ValidatorGovParticipation {
+	return ValidatorGovParticipation{
+		Address:            valAddr,
+		MissedVotesCounter: missedVotesCounter,
+		Votes:              votes,
+	}
+}
+
+// Return human readable governance participation
+func (p ValidatorGovParticipation) String() string {
+	var votes strings.Builder
+	for _, vote := range p.Votes {
+		votes.WriteString(fmt.Sprintf("\n    Proposal %d: voted %t", vote.ProposalID, vote.Voted))
+	}
+
+	return fmt.Sprintf(`Validator Gov Participation:
+  Address:              %s
+  Missed Votes Counter: %d
+  Votes:%s`,
+		p.Address, p.MissedVotesCounter, votes.String())
+}
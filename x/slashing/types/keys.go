@@ -25,6 +25,11 @@ const (
 	QueryParameters   = "parameters"
 	QuerySigningInfo  = "signingInfo"
 	QuerySigningInfos = "signingInfos"
+
+	QueryGovParticipation       = "govParticipation"
+	QueryGovParticipationPolicy = "govParticipationPolicy"
+
+	QueryDowntimeEscalationPolicy = "downtimeEscalationPolicy"
 )
 
 // Keys for slashing store
@@ -35,10 +40,16 @@ const (
 // - 0x02<consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddr_Bytes>: crypto.PubKey
+//
+// - 0x04<valAddress_Bytes>: int64
+//
+// - 0x05<valAddress_Bytes><proposalID_Bytes>: bool
 var (
 	ValidatorSigningInfoKey         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKey           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorMissedGovVotesKey      = []byte{0x04} // Prefix for missed governance votes counter
+	ValidatorGovVoteHistoryKey      = []byte{0x05} // Prefix for governance votes history
 )
 
 // stored by *Consensus* address (not operator address)
@@ -71,3 +82,29 @@ func GetValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func GetAddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKey, address...)
 }
+
+// stored by *operator* address (not consensus address)
+func GetValidatorMissedGovVotesKey(v sdk.ValAddress) []byte {
+	return append(ValidatorMissedGovVotesKey, v.Bytes()...)
+}
+
+// extract the address from a missed governance votes counter key
+func GetValidatorMissedGovVotesAddress(key []byte) (v sdk.ValAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.ValAddress(addr)
+}
+
+// stored by *operator* address (not consensus address)
+func GetValidatorGovVoteHistoryPrefixKey(v sdk.ValAddress) []byte {
+	return append(ValidatorGovVoteHistoryKey, v.Bytes()...)
+}
+
+// stored by *operator* address (not consensus address)
+func GetValidatorGovVoteHistoryKey(v sdk.ValAddress, proposalID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, proposalID)
+	return append(GetValidatorGovVoteHistoryPrefixKey(v), b...)
+}
@@ -14,6 +14,15 @@ const (
 	DefaultMaxEvidenceAge       = 60 * 2 * time.Second
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultMaxMissedGovVotes    = int64(3)
+	DefaultMaxDowntimeJail      = 60 * 60 * 24 * 7 * time.Second
+	DefaultDowntimeDecayPeriod  = int64(100000)
+
+	// MaxJailDurationFactor bounds the downtime jail duration factor
+	MaxJailDurationFactor = int64(100)
+	// GovVoteHistoryLength is the number of governance votes kept in the
+	// participation history of each validator
+	GovVoteHistoryLength = 100
 )
 
 // The Double Sign Jail period ends at Max Time supported by Amino (Dec 31, 9999 - 23:59:59 GMT)
@@ -32,11 +41,33 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyGovParticipationParams  = []byte("GovParticipationParams")
+	KeyDowntimeEscalation      = []byte("DowntimeEscalation")
 )
 
 // ParamKeyTable for slashing module
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable().RegisterParamSet(&Params{})
+	return params.NewKeyTable().RegisterParamSet(&Params{}).
+		RegisterType(KeyGovParticipationParams, GovParticipationParams{}).
+		RegisterType(KeyDowntimeEscalation, DowntimeEscalationParams{}).
+		RegisterValidator(KeyGovParticipationParams, validateGovParticipationParams).
+		RegisterValidator(KeyDowntimeEscalation, validateDowntimeEscalationParams)
+}
+
+func validateGovParticipationParams(_ sdk.Context, i interface{}) error {
+	p, ok := i.(GovParticipationParams)
+	if !ok {
+		return fmt.Errorf("invalid governance participation params type: %T", i)
+	}
+	return p.Validate()
+}
+
+func validateDowntimeEscalationParams(_ sdk.Context, i interface{}) error {
+	p, ok := i.(DowntimeEscalationParams)
+	if !ok {
+		return fmt.Errorf("invalid downtime escalation params type: %T", i)
+	}
+	return p.Validate()
 }
 
 // Params - used for initializing default parameter for slashing at genesis
@@ -100,3 +131,178 @@ func DefaultParams() Params {
 		SlashFractionDowntime:   DefaultSlashFractionDowntime,
 	}
 }
+
+// GovParticipationParams - policy applied to the bonded validators which do
+// not vote on governance proposals
+type GovParticipationParams struct {
+	Enabled        bool          `json:"enabled" yaml:"enabled"`                   // whether or not missing governance votes is punished
+	MaxMissedVotes int64         `json:"max_missed_votes" yaml:"max_missed_votes"` // missed votes tolerated before the validator is punished
+	Jail           bool          `json:"jail" yaml:"jail"`                         // whether or not the validator is jailed when punished
+	JailDuration   time.Duration `json:"jail_duration" yaml:"jail_duration"`       // duration the validator stays jailed for
+	SlashFraction  sdk.Dec       `json:"slash_fraction" yaml:"slash_fraction"`     // fraction of the validator stake slashed when punished
+}
+
+// NewGovParticipationParams creates a new GovParticipationParams object
+func NewGovParticipationParams(enabled bool, maxMissedVotes int64, jail bool,
+	jailDuration time.Duration, slashFraction sdk.Dec) GovParticipationParams {
+
+	return GovParticipationParams{
+		Enabled:        enabled,
+		MaxMissedVotes: maxMissedVotes,
+		Jail:           jail,
+		JailDuration:   jailDuration,
+		SlashFraction:  slashFraction,
+	}
+}
+
+// DefaultGovParticipationParams returns the policy used until it is set,
+// which doesn't punish validators missing governance votes
+func DefaultGovParticipationParams() GovParticipationParams {
+	return GovParticipationParams{
+		Enabled:        false,
+		MaxMissedVotes: DefaultMaxMissedGovVotes,
+		Jail:           true,
+		JailDuration:   DefaultDowntimeJailDuration,
+		SlashFraction:  sdk.ZeroDec(),
+	}
+}
+
+func (p GovParticipationParams) String() string {
+	return fmt.Sprintf(`Gov Participation Params:
+  Enabled:        %t
+  MaxMissedVotes: %d
+  Jail:           %t
+  JailDuration:   %s
+  SlashFraction:  %s`, p.Enabled, p.MaxMissedVotes, p.Jail,
+		p.JailDuration, p.SlashFraction)
+}
+
+// Validate checks that the governance participation policy is sane
+func (p GovParticipationParams) Validate() error {
+	if p.MaxMissedVotes < 0 {
+		return fmt.Errorf("Max missed governance votes must not be negative, is %d", p.MaxMissedVotes)
+	}
+	if p.JailDuration < 0 {
+		return fmt.Errorf("Governance jail duration must not be negative, is %s", p.JailDuration)
+	}
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("Slashing fraction for missing governance votes should be less than or equal to one and greater than or equal to zero, is %s", p.SlashFraction)
+	}
+	return nil
+}
+
+// DowntimeEscalationParams - graduated downtime policy, under which repeat
+// offenders get escalating jail durations and slash fractions
+type DowntimeEscalationParams struct {
+	Enabled            bool          `json:"enabled" yaml:"enabled"`                           // whether or not downtime penalties escalate
+	JailDurationFactor int64         `json:"jail_duration_factor" yaml:"jail_duration_factor"` // the jail duration is multiplied by this factor for each previous offence
+	SlashFractionStep  sdk.Dec       `json:"slash_fraction_step" yaml:"slash_fraction_step"`   // the slash fraction is increased by this step for each previous offence
+	MaxJailDuration    time.Duration `json:"max_jail_duration" yaml:"max_jail_duration"`       // cap of the escalated jail duration
+	MaxSlashFraction   sdk.Dec       `json:"max_slash_fraction" yaml:"max_slash_fraction"`     // cap of the escalated slash fraction
+	DecayPeriod        int64         `json:"decay_period" yaml:"decay_period"`                 // blocks in the validator set without offence after which one previous offence is forgiven, 0 to never forgive
+}
+
+// NewDowntimeEscalationParams creates a new DowntimeEscalationParams object
+func NewDowntimeEscalationParams(enabled bool, jailDurationFactor int64, slashFractionStep sdk.Dec,
+	maxJailDuration time.Duration, maxSlashFraction sdk.Dec, decayPeriod int64) DowntimeEscalationParams {
+
+	return DowntimeEscalationParams{
+		Enabled:            enabled,
+		JailDurationFactor: jailDurationFactor,
+		SlashFractionStep:  slashFractionStep,
+		MaxJailDuration:    maxJailDuration,
+		MaxSlashFraction:   maxSlashFraction,
+		DecayPeriod:        decayPeriod,
+	}
+}
+
+// DefaultDowntimeEscalationParams returns the policy used until it is set,
+// under which downtime penalties don't escalate
+func DefaultDowntimeEscalationParams() DowntimeEscalationParams {
+	return DowntimeEscalationParams{
+		Enabled:            false,
+		JailDurationFactor: 2,
+		SlashFractionStep:  sdk.NewDecWithPrec(1, 2),
+		MaxJailDuration:    DefaultMaxDowntimeJail,
+		MaxSlashFraction:   sdk.NewDecWithPrec(1, 1),
+		DecayPeriod:        DefaultDowntimeDecayPeriod,
+	}
+}
+
+func (p DowntimeEscalationParams) String() string {
+	return fmt.Sprintf(`Downtime Escalation Params:
+  Enabled:            %t
+  JailDurationFactor: %d
+  SlashFractionStep:  %s
+  MaxJailDuration:    %s
+  MaxSlashFraction:   %s
+  DecayPeriod:        %d`, p.Enabled, p.JailDurationFactor,
+		p.SlashFractionStep, p.MaxJailDuration, p.MaxSlashFraction, p.DecayPeriod)
+}
+
+// Validate checks that the graduated downtime policy is sane
+func (p DowntimeEscalationParams) Validate() error {
+	if p.JailDurationFactor < 1 || p.JailDurationFactor > MaxJailDurationFactor {
+		return fmt.Errorf("Downtime jail duration factor must be between 1 and %d, is %d",
+			MaxJailDurationFactor, p.JailDurationFactor)
+	}
+	if p.SlashFractionStep.IsNil() || p.SlashFractionStep.IsNegative() || p.SlashFractionStep.GT(sdk.OneDec()) {
+		return fmt.Errorf("Downtime slash fraction step should be less than or equal to one and greater than or equal to zero, is %s", p.SlashFractionStep)
+	}
+	if p.MaxJailDuration < 1*time.Minute {
+		return fmt.Errorf("Max downtime jail duration must be at least 1 minute, is %s", p.MaxJailDuration)
+	}
+	if p.MaxSlashFraction.IsNil() || p.MaxSlashFraction.IsNegative() || p.MaxSlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("Max downtime slash fraction should be less than or equal to one and greater than or equal to zero, is %s", p.MaxSlashFraction)
+	}
+	if p.DecayPeriod < 0 {
+		return fmt.Errorf("Downtime decay period must not be negative, is %d", p.DecayPeriod)
+	}
+	return nil
+}
+
+// Decay returns the number of previous offences once the ones forgiven during
+// the given number of blocks without offence have been removed
+func (p DowntimeEscalationParams) Decay(offences, cleanBlocks int64) int64 {
+	if p.DecayPeriod <= 0 || cleanBlocks <= 0 {
+		return offences
+	}
+	offences -= cleanBlocks / p.DecayPeriod
+	if offences < 0 {
+		return 0
+	}
+	return offences
+}
+
+// Escalate returns the jail duration and the slash fraction of a validator
+// with the given number of previous offences, from the base ones. The caps
+// never bring the penalties below the base ones.
+func (p DowntimeEscalationParams) Escalate(jailDuration time.Duration, slashFraction sdk.Dec,
+	offences int64) (time.Duration, sdk.Dec) {
+
+	escalatedJail := jailDuration
+	factor := time.Duration(p.JailDurationFactor)
+	for i := int64(0); i < offences && escalatedJail > 0 && escalatedJail < p.MaxJailDuration && factor > 1; i++ {
+		if escalatedJail > p.MaxJailDuration/factor {
+			// the next step would pass the cap, and could overflow
+			escalatedJail = p.MaxJailDuration
+			break
+		}
+		escalatedJail *= factor
+	}
+	if escalatedJail > p.MaxJailDuration {
+		escalatedJail = p.MaxJailDuration
+	}
+	if escalatedJail > jailDuration {
+		jailDuration = escalatedJail
+	}
+
+	escalatedSlash := slashFraction.Add(p.SlashFractionStep.MulInt64(offences))
+	if escalatedSlash.GT(p.MaxSlashFraction) {
+		escalatedSlash = p.MaxSlashFraction
+	}
+	if escalatedSlash.GT(slashFraction) {
+		slashFraction = escalatedSlash
+	}
+	return jailDuration, slashFraction
+}
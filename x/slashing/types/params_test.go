@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/barkisnet/barkis/types"
+)
+
+func TestDowntimeEscalation(t *testing.T) {
+	p := NewDowntimeEscalationParams(true, 3, sdk.NewDecWithPrec(1, 2), time.Hour, sdk.NewDecWithPrec(5, 2), 100)
+	require.NoError(t, p.Validate())
+
+	base, baseSlash := 5*time.Minute, sdk.NewDecWithPrec(1, 2)
+	tests := []struct {
+		offences      int64
+		jailDuration  time.Duration
+		slashFraction sdk.Dec
+	}{
+		{0, 5 * time.Minute, sdk.NewDecWithPrec(1, 2)},
+		{1, 15 * time.Minute, sdk.NewDecWithPrec(2, 2)},
+		{2, 45 * time.Minute, sdk.NewDecWithPrec(3, 2)},
+		{3, time.Hour, sdk.NewDecWithPrec(4, 2)},
+		{10, time.Hour, sdk.NewDecWithPrec(5, 2)},
+		{1000, time.Hour, sdk.NewDecWithPrec(5, 2)},
+	}
+	for _, tc := range tests {
+		jailDuration, slashFraction := p.Escalate(base, baseSlash, tc.offences)
+		require.Equal(t, tc.jailDuration, jailDuration, "offences: %d", tc.offences)
+		require.True(t, tc.slashFraction.Equal(slashFraction), "offences: %d, got %s", tc.offences, slashFraction)
+	}
+
+	// the caps never bring the penalties below the base ones
+	jailDuration, slashFraction := p.Escalate(2*time.Hour, sdk.NewDecWithPrec(1, 1), 2)
+	require.Equal(t, 2*time.Hour, jailDuration)
+	require.True(t, sdk.NewDecWithPrec(1, 1).Equal(slashFraction))
+
+	require.Equal(t, int64(3), p.Decay(3, 99))
+	require.Equal(t, int64(2), p.Decay(3, 100))
+	require.Equal(t, int64(0), p.Decay(3, 1000))
+	p.DecayPeriod = 0
+	require.Equal(t, int64(3), p.Decay(3, 1000))
+
+	// the escalated jail duration never overflows
+	p.JailDurationFactor = MaxJailDurationFactor
+	p.MaxJailDuration = time.Duration(1<<63 - 1)
+	require.NoError(t, p.Validate())
+	jailDuration, _ = p.Escalate(base, baseSlash, 1000)
+	require.Equal(t, p.MaxJailDuration, jailDuration)
+
+	p.JailDurationFactor = 0
+	require.Error(t, p.Validate())
+	p.JailDurationFactor = MaxJailDurationFactor + 1
+	require.Error(t, p.Validate())
+}
@@ -23,3 +23,13 @@ type QuerySigningInfosParams struct {
 func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
 	return QuerySigningInfosParams{page, limit}
 }
+
+// QueryGovParticipationParams defines the params for the following queries:
+// - 'custom/slashing/govParticipation'
+type QueryGovParticipationParams struct {
+	ValAddress sdk.ValAddress
+}
+
+func NewQueryGovParticipationParams(valAddr sdk.ValAddress) QueryGovParticipationParams {
+	return QueryGovParticipationParams{valAddr}
+}
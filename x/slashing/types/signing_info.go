@@ -9,12 +9,14 @@ import (
 
 // Signing info for a validator
 type ValidatorSigningInfo struct {
-	Address             sdk.ConsAddress `json:"address" yaml:"address"`                             // validator consensus address
-	StartHeight         int64           `json:"start_height" yaml:"start_height"`                   // height at which validator was first a candidate OR was unjailed
-	IndexOffset         int64           `json:"index_offset" yaml:"index_offset"`                   // index offset into signed block bit array
-	JailedUntil         time.Time       `json:"jailed_until" yaml:"jailed_until"`                   // timestamp validator cannot be unjailed until
-	Tombstoned          bool            `json:"tombstoned" yaml:"tombstoned"`                       // whether or not a validator has been tombstoned (killed out of validator set)
-	MissedBlocksCounter int64           `json:"missed_blocks_counter" yaml:"missed_blocks_counter"` // missed blocks counter (to avoid scanning the array every time)
+	Address             sdk.ConsAddress `json:"address" yaml:"address"`                                     // validator consensus address
+	StartHeight         int64           `json:"start_height" yaml:"start_height"`                           // height at which validator was first a candidate OR was unjailed
+	IndexOffset         int64           `json:"index_offset" yaml:"index_offset"`                           // index offset into signed block bit array
+	JailedUntil         time.Time       `json:"jailed_until" yaml:"jailed_until"`                           // timestamp validator cannot be unjailed until
+	Tombstoned          bool            `json:"tombstoned" yaml:"tombstoned"`                               // whether or not a validator has been tombstoned (killed out of validator set)
+	MissedBlocksCounter int64           `json:"missed_blocks_counter" yaml:"missed_blocks_counter"`         // missed blocks counter (to avoid scanning the array every time)
+	DowntimeOffences    int64           `json:"downtime_offences,omitempty" yaml:"downtime_offences"`       // previous downtime offences, when downtime penalties escalate
+	LastDowntimeHeight  int64           `json:"last_downtime_height,omitempty" yaml:"last_downtime_height"` // height of the last downtime offence, when downtime penalties escalate
 }
 
 // Construct a new `ValidatorSigningInfo` struct
@@ -41,7 +43,9 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Offences:     %d
+  Last Downtime Height:  %d`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeOffences, i.LastDowntimeHeight)
 }
@@ -105,6 +105,11 @@ var (
 	ErrSelfDelegationBelowMinimum      = types.ErrSelfDelegationBelowMinimum
 	ErrMinSelfDelegationInvalid        = types.ErrMinSelfDelegationInvalid
 	ErrMinSelfDelegationDecreased      = types.ErrMinSelfDelegationDecreased
+	ErrCommissionLTMinCommissionRate   = types.ErrCommissionLTMinCommissionRate
+	ErrCommissionGTMaxCommissionRate   = types.ErrCommissionGTMaxCommissionRate
+	ErrSelfBondBelowMinSelfBond        = types.ErrSelfBondBelowMinSelfBond
+	ErrSelfDelegationTransfer          = types.ErrSelfDelegationTransfer
+	ErrTransferDelegationRedelegating  = types.ErrTransferDelegationRedelegating
 	ErrNilDelegatorAddr                = types.ErrNilDelegatorAddr
 	ErrBadDenom                        = types.ErrBadDenom
 	ErrBadDelegationAddr               = types.ErrBadDelegationAddr
@@ -164,6 +169,7 @@ var (
 	NewMsgDelegate                     = types.NewMsgDelegate
 	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
 	NewMsgUndelegate                   = types.NewMsgUndelegate
+	NewMsgTransferDelegation           = types.NewMsgTransferDelegation
 	NewParams                          = types.NewParams
 	DefaultParams                      = types.DefaultParams
 	MustUnmarshalParams                = types.MustUnmarshalParams
@@ -200,6 +206,12 @@ var (
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyMinSelfBond                   = types.KeyMinSelfBond
+	KeyMinCommissionRate             = types.KeyMinCommissionRate
+	KeyMaxCommissionRate             = types.KeyMaxCommissionRate
+	DefaultMinSelfBond               = types.DefaultMinSelfBond
+	DefaultMinCommissionRate         = types.DefaultMinCommissionRate
+	DefaultMaxCommissionRate         = types.DefaultMaxCommissionRate
 )
 
 type (
@@ -230,6 +242,7 @@ type (
 	MsgDelegate               = types.MsgDelegate
 	MsgBeginRedelegate        = types.MsgBeginRedelegate
 	MsgUndelegate             = types.MsgUndelegate
+	MsgTransferDelegation     = types.MsgTransferDelegation
 	Params                    = types.Params
 	Pool                      = types.Pool
 	QueryDelegatorParams      = types.QueryDelegatorParams
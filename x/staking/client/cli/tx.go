@@ -38,6 +38,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdDelegate(cdc),
 		GetCmdRedelegate(storeKey, cdc),
 		GetCmdUnbond(storeKey, cdc),
+		GetCmdTransferDelegation(cdc),
 	)...)
 
 	return stakingTxCmd
@@ -245,6 +246,48 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 	}
 }
 
+// GetCmdTransferDelegation implements the transfer delegation command.
+func GetCmdTransferDelegation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-delegation [validator-addr] [new-delegator-addr] [amount]",
+		Short: "Transfer bonded tokens of a delegation to another delegator",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer an amount of bonded tokens of a delegation to another delegator
+without unbonding them. The pending rewards of the delegation are withdrawn first.
+
+Example:
+$ %s tx staking transfer-delegation cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9 100stake --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			newDelAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferDelegation(delAddr, valAddr, newDelAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 //__________________________________________________________
 
 var (
@@ -33,6 +33,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgUndelegate:
 			return handleMsgUndelegate(ctx, msg, k)
 
+		case types.MsgTransferDelegation:
+			return handleMsgTransferDelegation(ctx, msg, k)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -42,6 +45,11 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 // Called every block, update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	// Jail the validators whose self-delegation has fallen below the minimum
+	// self bond, before the validator set changes get calculated so they are
+	// removed from the bonded set in this very block.
+	k.JailValidatorsBelowMinSelfBond(ctx)
+
 	// Calculate validator set changes.
 	//
 	// NOTE: ApplyAndReturnValidatorSetUpdates has to come before
@@ -112,6 +120,14 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		return ErrBadDenom(k.Codespace()).Result()
 	}
 
+	if minSelfBond := k.MinSelfBond(ctx); msg.Value.Amount.LT(minSelfBond) {
+		return ErrSelfBondBelowMinSelfBond(k.Codespace(), msg.Value.Amount, minSelfBond).Result()
+	}
+
+	if err := k.ValidateCommissionRateBounds(ctx, msg.Commission.Rate); err != nil {
+		return err.Result()
+	}
+
 	if _, err := msg.Description.EnsureLength(); err != nil {
 		return err.Result()
 	}
@@ -144,6 +160,9 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	// call the after-creation hook
 	k.AfterValidatorCreated(ctx, validator.OperatorAddress)
 
+	// the hook may have modified the validator (e.g. jailed it), so reload it
+	validator, _ = k.GetValidator(ctx, validator.OperatorAddress)
+
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
 	// NOTE source will always be from a wallet which are unbonded
@@ -327,3 +346,37 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 
 	return sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}
 }
+
+func handleMsgTransferDelegation(ctx sdk.Context, msg types.MsgTransferDelegation, k keeper.Keeper) sdk.Result {
+	shares, err := k.ValidateUnbondAmount(
+		ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount.Amount,
+	)
+	if err != nil {
+		return err.Result()
+	}
+
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return ErrBadDenom(k.Codespace()).Result()
+	}
+
+	_, err = k.TransferDelegation(ctx, msg.DelegatorAddress, msg.NewDelegatorAddress, msg.ValidatorAddress, shares)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyNewDelegator, msg.NewDelegatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
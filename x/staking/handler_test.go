@@ -408,6 +408,127 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	require.False(t, got.IsOK(), "should not be able to increase minSelfDelegation above current self delegation")
 }
 
+func TestCommissionRateBounds(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.MaxCommissionRate = sdk.NewDecWithPrec(20, 2)
+	keeper.SetParams(ctx, params)
+
+	// commission below the minimum
+	msgCreateValidator := NewTestMsgCreateValidatorWithCommission(sdk.ValAddress(keep.Addrs[0]), keep.PKs[0],
+		sdk.NewInt(10), sdk.NewDecWithPrec(1, 2))
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.False(t, got.IsOK(), "expected create-validator to fail with a commission below the minimum")
+
+	// commission above the maximum
+	msgCreateValidator = NewTestMsgCreateValidatorWithCommission(sdk.ValAddress(keep.Addrs[0]), keep.PKs[0],
+		sdk.NewInt(10), sdk.NewDecWithPrec(25, 2))
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.False(t, got.IsOK(), "expected create-validator to fail with a commission above the maximum")
+
+	// commission within the bounds
+	commission := NewCommissionRates(sdk.NewDecWithPrec(10, 2), sdk.OneDec(), sdk.OneDec())
+	msgCreateValidator = NewMsgCreateValidator(sdk.ValAddress(keep.Addrs[0]), keep.PKs[0],
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)), Description{}, commission, sdk.OneInt())
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(25 * time.Hour))
+
+	// editing the commission is bounded too
+	newRate := sdk.NewDecWithPrec(30, 2)
+	msgEditValidator := NewMsgEditValidator(sdk.ValAddress(keep.Addrs[0]), Description{}, &newRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.False(t, got.IsOK(), "expected edit-validator to fail with a commission above the maximum")
+
+	newRate = sdk.NewDecWithPrec(4, 2)
+	msgEditValidator = NewMsgEditValidator(sdk.ValAddress(keep.Addrs[0]), Description{}, &newRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.False(t, got.IsOK(), "expected edit-validator to fail with a commission below the minimum")
+
+	newRate = sdk.NewDecWithPrec(15, 2)
+	msgEditValidator = NewMsgEditValidator(sdk.ValAddress(keep.Addrs[0]), Description{}, &newRate, nil)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.True(t, got.IsOK(), "expected edit-validator to be ok, got %v", got)
+}
+
+func TestJailValidatorBelowMinSelfBond(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	params := keeper.GetParams(ctx)
+	params.MinSelfBond = sdk.TokensFromConsensusPower(10)
+	keeper.SetParams(ctx, params)
+
+	// self bond below the minimum
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], sdk.TokensFromConsensusPower(9))
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.False(t, got.IsOK(), "expected create-validator to fail with a self bond below the minimum")
+
+	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], sdk.TokensFromConsensusPower(20))
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	EndBlocker(ctx, keeper)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.False(t, validator.Jailed)
+	require.True(t, validator.IsBonded())
+
+	// unbond half of the self bond, staying at the minimum
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))
+	msgUndelegate := NewMsgUndelegate(sdk.AccAddress(validatorAddr), validatorAddr, unbondAmt)
+	got = handleMsgUndelegate(ctx, msgUndelegate, keeper)
+	require.True(t, got.IsOK(), "expected no error: %v", got)
+
+	EndBlocker(ctx, keeper)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.False(t, validator.Jailed)
+
+	// raising the minimum self bond jails the validator
+	params.MinSelfBond = sdk.TokensFromConsensusPower(11)
+	keeper.SetParams(ctx, params)
+
+	EndBlocker(ctx, keeper)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.Jailed)
+	require.False(t, validator.IsBonded())
+}
+
+func TestJailBondedValidatorsBelowMinSelfBond(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 1
+	keeper.SetParams(ctx, params)
+
+	// the first validator has the most power but the lowest self bond of
+	// the two validators competing for the bonded set
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1]), sdk.ValAddress(keep.Addrs[2])}
+	for i, selfBond := range []int64{10, 20, 5} {
+		msgCreateValidator := NewTestMsgCreateValidator(valAddrs[i], keep.PKs[i], sdk.TokensFromConsensusPower(selfBond))
+		got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+		require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	}
+	msgDelegate := NewTestMsgDelegate(keep.Addrs[3], valAddrs[0], sdk.TokensFromConsensusPower(30))
+	got := handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected delegation to be ok, got %v", got)
+
+	params.MinSelfBond = sdk.TokensFromConsensusPower(15)
+	keeper.SetParams(ctx, params)
+
+	// the second validator takes the place of the jailed one, the third one
+	// is left alone out of the bonded set
+	EndBlocker(ctx, keeper)
+	validator, _ := keeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, validator.Jailed)
+	validator, _ = keeper.GetValidator(ctx, valAddrs[1])
+	require.False(t, validator.Jailed)
+	require.True(t, validator.IsBonded())
+	validator, _ = keeper.GetValidator(ctx, valAddrs[2])
+	require.False(t, validator.Jailed)
+	require.False(t, validator.IsBonded())
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 	initBond := sdk.TokensFromConsensusPower(initPower)
@@ -617,6 +738,69 @@ func TestMultipleMsgDelegate(t *testing.T) {
 	}
 }
 
+func TestTransferDelegation(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	validatorAddr, delegatorAddr, newDelegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1], keep.Addrs[2]
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], sdk.NewInt(10))
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, sdk.NewInt(10))
+	got = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected delegation to be ok, got %v", got)
+
+	// can't transfer to the delegator itself
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(4))
+	msgTransfer := NewMsgTransferDelegation(delegatorAddr, validatorAddr, delegatorAddr, amount)
+	require.NotNil(t, msgTransfer.ValidateBasic())
+
+	// can't transfer more than delegated
+	msgTransfer = NewMsgTransferDelegation(delegatorAddr, validatorAddr, newDelegatorAddr,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(11)))
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.False(t, got.IsOK(), "expected transfer of more than delegated to fail")
+
+	// transfer part of the delegation
+	msgTransfer = NewMsgTransferDelegation(delegatorAddr, validatorAddr, newDelegatorAddr, amount)
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.True(t, got.IsOK(), "expected transfer to be ok, got %v", got)
+
+	delegation, found := keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(6), delegation.Shares)
+	newDelegation, found := keeper.GetDelegation(ctx, newDelegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(4), newDelegation.Shares)
+
+	// the validator is left untouched
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(20), validator.Tokens)
+	require.Equal(t, sdk.NewDec(20), validator.DelegatorShares)
+
+	// transfer the rest of the delegation
+	amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(6))
+	msgTransfer = NewMsgTransferDelegation(delegatorAddr, validatorAddr, newDelegatorAddr, amount)
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.True(t, got.IsOK(), "expected transfer to be ok, got %v", got)
+
+	_, found = keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+	newDelegation, found = keeper.GetDelegation(ctx, newDelegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), newDelegation.Shares)
+
+	// transferring the self delegation below the minimum jails the validator
+	amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))
+	msgTransfer = NewMsgTransferDelegation(sdk.AccAddress(validatorAddr), validatorAddr, newDelegatorAddr, amount)
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.True(t, got.IsOK(), "expected transfer to be ok, got %v", got)
+
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.Jailed)
+}
+
 func TestJailValidator(t *testing.T) {
 	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
@@ -592,6 +592,88 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 	return amount, nil
 }
 
+// TransferDelegation moves shares of a delegation to another delegator without
+// unbonding them. The rewards of both delegations are settled by the hooks
+// before their shares are modified. It returns the amount of tokens the
+// transferred shares are worth.
+func (k Keeper) TransferDelegation(ctx sdk.Context, delAddr, newDelAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, shares sdk.Dec) (amount sdk.Int, err sdk.Error) {
+
+	if delAddr.Equals(newDelAddr) {
+		return amount, types.ErrSelfDelegationTransfer(k.Codespace())
+	}
+
+	// check if a delegation object exists in the store
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return amount, types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+
+	// ensure that we have enough shares to transfer
+	if delegation.Shares.LT(shares) {
+		return amount, types.ErrNotEnoughDelegationShares(k.Codespace(), delegation.Shares.String())
+	}
+
+	// get validator
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return amount, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the shares received through a redelegation must stay with their delegator
+	// until it matures, so that they can still be slashed for infractions
+	// committed at the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return amount, types.ErrTransferDelegationRedelegating(k.Codespace())
+	}
+
+	// call the before-delegation-modified hook
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+
+	// track the transfer of the delegated coins, respecting vesting accounts
+	amount = validator.TokensFromShares(shares).TruncateInt()
+	if amount.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amount))
+		if err := k.supplyKeeper.TransferDelegatedCoins(ctx, delAddr, newDelAddr, coins); err != nil {
+			return amount, err
+		}
+	}
+
+	// subtract shares from delegation
+	delegation.Shares = delegation.Shares.Sub(shares)
+
+	// if the delegation is the operator of the validator and the transfer decreases the validator's self delegation
+	// below their minimum trigger a jail validator
+	if delegation.DelegatorAddress.Equals(validator.OperatorAddress) && !validator.Jailed &&
+		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+
+		k.jailValidator(ctx, validator)
+	}
+
+	if delegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+		// call the after delegation modification hook
+		k.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+	}
+
+	// add shares to the delegation of the new delegator
+	newDelegation, found := k.GetDelegation(ctx, newDelAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, newDelAddr, valAddr)
+	} else {
+		newDelegation = types.NewDelegation(newDelAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, newDelAddr, valAddr)
+	}
+
+	newDelegation.Shares = newDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, newDelegation)
+	k.AfterDelegationModified(ctx, newDelAddr, valAddr)
+
+	return amount, nil
+}
+
 // getBeginInfo returns the completion time and height of a redelegation, along
 // with a boolean signaling if the redelegation is complete based on the source
 // validator.
@@ -48,7 +48,7 @@ func NewKeeper(cdc *codec.Codec, key, tkey sdk.StoreKey, supplyKeeper types.Supp
 		panic(fmt.Sprintf("%s module account has not been set", types.NotBondedPoolName))
 	}
 
-	return Keeper{
+	keeper := Keeper{
 		storeKey:           key,
 		storeTKey:          tkey,
 		cdc:                cdc,
@@ -59,6 +59,18 @@ func NewKeeper(cdc *codec.Codec, key, tkey sdk.StoreKey, supplyKeeper types.Supp
 		validatorCacheList: list.New(),
 		codespace:          codespace,
 	}
+
+	// the commission rate validators check the rates against each other, so
+	// they are bound to the keeper
+	keeper.paramstore = keeper.paramstore.UpdateKeyTable(params.NewKeyTable().
+		RegisterType(types.KeyMinSelfBond, sdk.Int{}).
+		RegisterValidator(types.KeyMinSelfBond, validateMinSelfBond).
+		RegisterType(types.KeyMinCommissionRate, sdk.Dec{}).
+		RegisterValidator(types.KeyMinCommissionRate, keeper.validateMinCommissionRate).
+		RegisterType(types.KeyMaxCommissionRate, sdk.Dec{}).
+		RegisterValidator(types.KeyMaxCommissionRate, keeper.validateMaxCommissionRate))
+
+	return keeper
 }
 
 // Logger returns a module-specific logger.
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/barkisnet/barkis/types"
 	"github.com/barkisnet/barkis/x/staking/types"
 )
 
@@ -22,3 +23,41 @@ func TestParams(t *testing.T) {
 	resParams = keeper.GetParams(ctx)
 	require.True(t, expParams.Equal(resParams))
 }
+
+func TestUpdateParams(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 0)
+
+	require.NoError(t, keeper.paramstore.Update(ctx, types.KeyMinSelfBond, []byte(`"100"`)))
+	require.Equal(t, sdk.NewInt(100), keeper.MinSelfBond(ctx))
+	require.Error(t, keeper.paramstore.Update(ctx, types.KeyMinSelfBond, []byte(`"-1"`)))
+	require.Equal(t, sdk.NewInt(100), keeper.MinSelfBond(ctx))
+
+	tests := []struct {
+		name       string
+		key        []byte
+		rate       string
+		expectPass bool
+	}{
+		{"negative min rate", types.KeyMinCommissionRate, "-0.1", false},
+		{"max rate greater than one", types.KeyMaxCommissionRate, "1.1", false},
+		{"lower max rate", types.KeyMaxCommissionRate, "0.5", true},
+		{"min rate greater than max rate", types.KeyMinCommissionRate, "0.6", false},
+		{"min rate equal to max rate", types.KeyMinCommissionRate, "0.5", true},
+		{"max rate lower than min rate", types.KeyMaxCommissionRate, "0.4", false},
+		{"higher max rate", types.KeyMaxCommissionRate, "0.8", true},
+		{"higher min rate", types.KeyMinCommissionRate, "0.6", true},
+	}
+
+	for _, tc := range tests {
+		rate := sdk.MustNewDecFromStr(tc.rate)
+		bz := types.ModuleCdc.MustMarshalJSON(rate)
+		err := keeper.paramstore.Update(ctx, tc.key, bz)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), keeper.MinCommissionRate(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(8, 1), keeper.MaxCommissionRate(ctx))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/barkisnet/barkis/types"
@@ -43,6 +44,34 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MinSelfBond - Minimum self-delegation required from every validator
+func (k Keeper) MinSelfBond(ctx sdk.Context) (res sdk.Int) {
+	// unmarshal into a fresh value, the default one must not be overwritten
+	if !k.paramstore.Has(ctx, types.KeyMinSelfBond) {
+		return types.DefaultMinSelfBond
+	}
+	k.paramstore.Get(ctx, types.KeyMinSelfBond, &res)
+	return
+}
+
+// MinCommissionRate - Minimum commission rate of every validator
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	if !k.paramstore.Has(ctx, types.KeyMinCommissionRate) {
+		return types.DefaultMinCommissionRate
+	}
+	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
+// MaxCommissionRate - Maximum commission rate of every validator
+func (k Keeper) MaxCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	if !k.paramstore.Has(ctx, types.KeyMaxCommissionRate) {
+		return types.DefaultMaxCommissionRate
+	}
+	k.paramstore.Get(ctx, types.KeyMaxCommissionRate, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -50,10 +79,60 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxValidators(ctx),
 		k.MaxEntries(ctx),
 		k.BondDenom(ctx),
+		k.MinSelfBond(ctx),
+		k.MinCommissionRate(ctx),
+		k.MaxCommissionRate(ctx),
 	)
 }
 
 // set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	params = params.WithDefaults()
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// validateMinSelfBond checks the minimum self-bond set by a parameter change
+func validateMinSelfBond(_ sdk.Context, i interface{}) error {
+	minSelfBond, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid min self bond type: %T", i)
+	}
+	if minSelfBond == (sdk.Int{}) || minSelfBond.IsNegative() {
+		return fmt.Errorf("staking parameter MinSelfBond can't be negative: %s", minSelfBond)
+	}
+	return nil
+}
+
+// validateMinCommissionRate checks that the minimum commission rate set by a
+// parameter change is within [0, MaxCommissionRate]
+func (k Keeper) validateMinCommissionRate(ctx sdk.Context, i interface{}) error {
+	rate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid min commission rate type: %T", i)
+	}
+	if rate.IsNil() || rate.IsNegative() {
+		return fmt.Errorf("staking parameter MinCommissionRate can't be negative: %s", rate)
+	}
+	if maxRate := k.MaxCommissionRate(ctx); rate.GT(maxRate) {
+		return fmt.Errorf("staking parameter MinCommissionRate %s can't be greater than MaxCommissionRate %s",
+			rate, maxRate)
+	}
+	return nil
+}
+
+// validateMaxCommissionRate checks that the maximum commission rate set by a
+// parameter change is within [MinCommissionRate, 1]
+func (k Keeper) validateMaxCommissionRate(ctx sdk.Context, i interface{}) error {
+	rate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid max commission rate type: %T", i)
+	}
+	if rate.IsNil() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MaxCommissionRate can't be greater than 1: %s", rate)
+	}
+	if minRate := k.MinCommissionRate(ctx); rate.LT(minRate) {
+		return fmt.Errorf("staking parameter MinCommissionRate %s can't be greater than MaxCommissionRate %s",
+			minRate, rate)
+	}
+	return nil
+}
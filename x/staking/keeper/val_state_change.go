@@ -182,6 +182,53 @@ func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
 	k.DeleteValidatorByPowerIndex(ctx, validator)
 }
 
+// JailValidatorsBelowMinSelfBond jails the validators whose self-delegation
+// has fallen below the minimum self bond set by governance. Only the validators
+// which are or become part of the bonded validator set in this block are
+// checked, the others are checked once they have the power to enter it. It
+// returns the validators which have been jailed.
+func (k Keeper) JailValidatorsBelowMinSelfBond(ctx sdk.Context) (jailed []types.Validator) {
+	minSelfBond := k.MinSelfBond(ctx)
+	if !minSelfBond.IsPositive() {
+		return nil
+	}
+
+	// the validators below the minimum self bond do not count, as the next
+	// ones by power take their place in the bonded validator set
+	maxValidators := k.MaxValidators(ctx)
+	iterator := k.ValidatorsPowerStoreIterator(ctx)
+	for count := 0; iterator.Valid() && count < int(maxValidators); iterator.Next() {
+		validator := k.mustGetValidator(ctx, iterator.Value())
+		if validator.PotentialConsensusPower() == 0 {
+			break
+		}
+
+		if k.GetValidatorSelfBond(ctx, validator).GTE(minSelfBond) {
+			count++
+			continue
+		}
+		jailed = append(jailed, validator)
+	}
+	iterator.Close()
+
+	for i, validator := range jailed {
+		selfBond := k.GetValidatorSelfBond(ctx, validator)
+		k.jailValidator(ctx, validator)
+		k.Logger(ctx).Info(fmt.Sprintf("validator %s jailed, self bond %s is below the minimum self bond %s",
+			validator.OperatorAddress, selfBond, minSelfBond))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeJailBelowSelfBond,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeySelfBond, selfBond.String()),
+			),
+		)
+		jailed[i].Jailed = true
+	}
+	return jailed
+}
+
 // remove a validator from jail
 func (k Keeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
 	if !validator.Jailed {
@@ -161,6 +161,10 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	commission := validator.Commission
 	blockTime := ctx.BlockHeader().Time
 
+	if err := k.ValidateCommissionRateBounds(ctx, newRate); err != nil {
+		return commission, err
+	}
+
 	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
 		return commission, err
 	}
@@ -171,6 +175,28 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	return commission, nil
 }
 
+// ValidateCommissionRateBounds checks that a commission rate lies within the
+// minimum and maximum commission rates set by governance
+func (k Keeper) ValidateCommissionRateBounds(ctx sdk.Context, rate sdk.Dec) sdk.Error {
+	if minRate := k.MinCommissionRate(ctx); rate.LT(minRate) {
+		return types.ErrCommissionLTMinCommissionRate(k.Codespace(), rate, minRate)
+	}
+	if maxRate := k.MaxCommissionRate(ctx); rate.GT(maxRate) {
+		return types.ErrCommissionGTMaxCommissionRate(k.Codespace(), rate, maxRate)
+	}
+	return nil
+}
+
+// GetValidatorSelfBond returns the tokens the operator of a validator has
+// delegated to it, zero if there is no self-delegation
+func (k Keeper) GetValidatorSelfBond(ctx sdk.Context, validator types.Validator) sdk.Int {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.OperatorAddress), validator.OperatorAddress)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
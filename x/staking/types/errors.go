@@ -103,6 +103,21 @@ func ErrMinSelfDelegationDecreased(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "minimum self delegation cannot be decrease")
 }
 
+func ErrCommissionLTMinCommissionRate(codespace sdk.CodespaceType, rate, minRate sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator,
+		fmt.Sprintf("commission rate %s cannot be less than the minimum commission rate %s", rate, minRate))
+}
+
+func ErrCommissionGTMaxCommissionRate(codespace sdk.CodespaceType, rate, maxRate sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator,
+		fmt.Sprintf("commission rate %s cannot be more than the maximum commission rate %s", rate, maxRate))
+}
+
+func ErrSelfBondBelowMinSelfBond(codespace sdk.CodespaceType, selfBond, minSelfBond sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator,
+		fmt.Sprintf("validator's self bond %s cannot be less than the minimum self bond %s", selfBond, minSelfBond))
+}
+
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "delegator address is nil")
 }
@@ -191,6 +206,15 @@ func ErrTransitiveRedelegation(codespace sdk.CodespaceType) sdk.Error {
 		"redelegation to this validator already in progress, first redelegation to this validator must complete before next redelegation")
 }
 
+func ErrSelfDelegationTransfer(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "cannot transfer a delegation to the same delegator")
+}
+
+func ErrTransferDelegationRedelegating(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"redelegation to this validator in progress, the redelegation must complete before transferring the delegation")
+}
+
 func ErrMaxRedelegationEntries(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation,
 		"too many redelegation entries in this delegator/src-validator/dst-validator trio, please wait for some entries to mature")
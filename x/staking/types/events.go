@@ -9,6 +9,8 @@ var (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeTransferDelegation   = "transfer_delegation"
+	EventTypeJailBelowSelfBond    = "jail_below_min_self_bond"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -16,6 +18,8 @@ var (
 	AttributeKeySrcValidator      = "source_validator"
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
+	AttributeKeyNewDelegator      = "new_delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeySelfBond          = "self_bond"
 	AttributeValueCategory        = ModuleName
 )
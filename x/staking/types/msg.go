@@ -16,6 +16,7 @@ var (
 	_ sdk.Msg = &MsgDelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgBeginRedelegate{}
+	_ sdk.Msg = &MsgTransferDelegation{}
 )
 
 //______________________________________________________________________
@@ -343,3 +344,56 @@ func (msg MsgUndelegate) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// MsgTransferDelegation - struct for moving a delegation to another delegator
+// without unbonding it
+type MsgTransferDelegation struct {
+	DelegatorAddress    sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress    sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	NewDelegatorAddress sdk.AccAddress `json:"new_delegator_address" yaml:"new_delegator_address"`
+	Amount              sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgTransferDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	newDelAddr sdk.AccAddress, amount sdk.Coin) MsgTransferDelegation {
+
+	return MsgTransferDelegation{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		NewDelegatorAddress: newDelAddr,
+		Amount:              amount,
+	}
+}
+
+//nolint
+func (msg MsgTransferDelegation) Route() string { return RouterKey }
+func (msg MsgTransferDelegation) Type() string  { return "transfer_delegation" }
+func (msg MsgTransferDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgTransferDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgTransferDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.NewDelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.DelegatorAddress.Equals(msg.NewDelegatorAddress) {
+		return ErrSelfDelegationTransfer(DefaultCodespace)
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
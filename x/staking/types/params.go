@@ -24,12 +24,26 @@ const (
 	DefaultMaxEntries uint16 = 7
 )
 
+// Staking params default values which can't be declared as constants
+var (
+	// Default minimum self-bond of a validator, zero disables the check
+	DefaultMinSelfBond = sdk.ZeroInt()
+
+	// Default minimum and maximum commission rates of a validator
+	DefaultMinCommissionRate = sdk.ZeroDec()
+	DefaultMaxCommissionRate = sdk.OneDec()
+)
+
 // nolint - Keys for parameter access
 var (
 	KeyUnbondingTime = []byte("UnbondingTime")
 	KeyMaxValidators = []byte("MaxValidators")
 	KeyMaxEntries    = []byte("KeyMaxEntries")
 	KeyBondDenom     = []byte("BondDenom")
+
+	KeyMinSelfBond       = []byte("MinSelfBond")
+	KeyMinCommissionRate = []byte("MinCommissionRate")
+	KeyMaxCommissionRate = []byte("MaxCommissionRate")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -41,17 +55,24 @@ type Params struct {
 	MaxEntries    uint16        `json:"max_entries" yaml:"max_entries"`       // max entries for either unbonding delegation or redelegation (per pair/trio)
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
 	BondDenom string `json:"bond_denom" yaml:"bond_denom"` // bondable coin denomination
+
+	MinSelfBond       sdk.Int `json:"min_self_bond,omitempty" yaml:"min_self_bond"`             // minimum self-delegation of every validator
+	MinCommissionRate sdk.Dec `json:"min_commission_rate,omitempty" yaml:"min_commission_rate"` // minimum commission rate of every validator
+	MaxCommissionRate sdk.Dec `json:"max_commission_rate,omitempty" yaml:"max_commission_rate"` // maximum commission rate of every validator
 }
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+	bondDenom string, minSelfBond sdk.Int, minCommissionRate, maxCommissionRate sdk.Dec) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:     unbondingTime,
+		MaxValidators:     maxValidators,
+		MaxEntries:        maxEntries,
+		BondDenom:         bondDenom,
+		MinSelfBond:       minSelfBond,
+		MinCommissionRate: minCommissionRate,
+		MaxCommissionRate: maxCommissionRate,
 	}
 }
 
@@ -62,6 +83,9 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{KeyMaxValidators, &p.MaxValidators},
 		{KeyMaxEntries, &p.MaxEntries},
 		{KeyBondDenom, &p.BondDenom},
+		{KeyMinSelfBond, &p.MinSelfBond},
+		{KeyMinCommissionRate, &p.MinCommissionRate},
+		{KeyMaxCommissionRate, &p.MaxCommissionRate},
 	}
 }
 
@@ -75,7 +99,23 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom,
+		DefaultMinSelfBond, DefaultMinCommissionRate, DefaultMaxCommissionRate)
+}
+
+// WithDefaults returns a copy of the parameters where the fields missing from
+// genesis files predating them are set to their default values.
+func (p Params) WithDefaults() Params {
+	if p.MinSelfBond == (sdk.Int{}) {
+		p.MinSelfBond = DefaultMinSelfBond
+	}
+	if p.MinCommissionRate.IsNil() {
+		p.MinCommissionRate = DefaultMinCommissionRate
+	}
+	if p.MaxCommissionRate.IsNil() {
+		p.MaxCommissionRate = DefaultMaxCommissionRate
+	}
+	return p
 }
 
 // String returns a human readable string representation of the parameters.
@@ -84,8 +124,12 @@ func (p Params) String() string {
   Unbonding Time:    %s
   Max Validators:    %d
   Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Bonded Coin Denom: %s
+  Min Self Bond:     %s
+  Min Commission:    %s
+  Max Commission:    %s`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.BondDenom,
+		p.MinSelfBond, p.MinCommissionRate, p.MaxCommissionRate)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -114,5 +158,20 @@ func (p Params) Validate() error {
 	if p.MaxValidators == 0 {
 		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
 	}
+
+	p = p.WithDefaults()
+	if p.MinSelfBond.IsNegative() {
+		return fmt.Errorf("staking parameter MinSelfBond can't be negative: %s", p.MinSelfBond)
+	}
+	if p.MinCommissionRate.IsNegative() {
+		return fmt.Errorf("staking parameter MinCommissionRate can't be negative: %s", p.MinCommissionRate)
+	}
+	if p.MaxCommissionRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MaxCommissionRate can't be greater than 1: %s", p.MaxCommissionRate)
+	}
+	if p.MinCommissionRate.GT(p.MaxCommissionRate) {
+		return fmt.Errorf("staking parameter MinCommissionRate %s can't be greater than MaxCommissionRate %s",
+			p.MinCommissionRate, p.MaxCommissionRate)
+	}
 	return nil
 }
@@ -84,6 +84,14 @@ func (k Keeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule
 	return k.bk.UndelegateCoins(ctx, acc.GetAddress(), recipientAddr, amt)
 }
 
+// TransferDelegatedCoins tracks the transfer of delegated coins from one
+// delegator account to another, the coins stay in the staking module accounts.
+func (k Keeper) TransferDelegatedCoins(ctx sdk.Context, senderAddr, recipientAddr sdk.AccAddress,
+	amt sdk.Coins) sdk.Error {
+
+	return k.bk.TransferDelegatedCoins(ctx, senderAddr, recipientAddr, amt)
+}
+
 // MintCoins creates new coins from thin air and adds it to the module account.
 // Panics if the name maps to a non-minter module account or if the amount is invalid.
 func (k Keeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error {